@@ -0,0 +1,72 @@
+package strings
+
+import (
+	"golang.org/x/text/cases"
+	"strings"
+)
+
+// Acronyms is a set of acronyms and initialisms, like "ID" or "URL", that the case conversion functions
+// keep in upper case when capitalizing words, the way golint does for Go identifiers.
+//
+// Keys are the upper case form of the acronym. Use NewAcronyms or Add to build one so that keys are normalized.
+type Acronyms map[string]bool
+
+// DefaultAcronyms is the set of acronyms used by the case conversion functions when no other set is given.
+// It starts out with the initialisms golint recognizes. It can be changed at startup to suit the application,
+// but it is not safe to change while other goroutines are converting strings.
+var DefaultAcronyms = NewAcronyms(
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID",
+	"IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP",
+	"TLS", "TTL", "UDP", "UI", "UID", "UUID", "URI", "URL", "UTF8", "VM", "XML", "XMPP",
+	"XSRF", "XSS",
+)
+
+// NewAcronyms returns a set of acronyms containing the given words.
+func NewAcronyms(words ...string) Acronyms {
+	a := make(Acronyms, len(words))
+	a.Add(words...)
+	return a
+}
+
+// Add adds the given words to the set of acronyms.
+func (a Acronyms) Add(words ...string) {
+	for _, w := range words {
+		a[strings.ToUpper(w)] = true
+	}
+}
+
+// Remove removes the given words from the set of acronyms.
+func (a Acronyms) Remove(words ...string) {
+	for _, w := range words {
+		delete(a, strings.ToUpper(w))
+	}
+}
+
+// Has returns true if w is in the set of acronyms, ignoring case.
+func (a Acronyms) Has(w string) bool {
+	return a[strings.ToUpper(w)]
+}
+
+// upper returns the upper case form of w if w is an acronym, or the plural of an acronym,
+// like "IDs". Otherwise, it returns an empty string.
+func (a Acronyms) upper(w string) string {
+	if a.Has(w) {
+		return strings.ToUpper(w)
+	}
+	if l := len(w); l > 2 && (w[l-1] == 's' || w[l-1] == 'S') && a.Has(w[:l-1]) {
+		return strings.ToUpper(w[:l-1]) + "s"
+	}
+	return ""
+}
+
+// capitalize capitalizes w using title, keeping it in upper case if it is an acronym.
+// A word that starts with a number, like "2nd", has nothing to capitalize and is put in lower case.
+func (a Acronyms) capitalize(w string, title, lower cases.Caser) string {
+	if u := a.upper(w); u != "" {
+		return u
+	}
+	if !startsWithLetter(w) {
+		return lower.String(w)
+	}
+	return title.String(w)
+}
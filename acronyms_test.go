@@ -0,0 +1,49 @@
+package strings
+
+import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"testing"
+)
+
+func TestAcronyms(t *testing.T) {
+	a := NewAcronyms("id", "Sku")
+	if !a.Has("ID") || !a.Has("id") || !a.Has("SKU") {
+		t.Error("NewAcronyms() did not normalize its words")
+	}
+	a.Add("pdf")
+	if !a.Has("Pdf") {
+		t.Error("Add() did not add PDF")
+	}
+	a.Remove("Id")
+	if a.Has("ID") {
+		t.Error("Remove() did not remove ID")
+	}
+	if a.Has("") {
+		t.Error("Has() found an empty string")
+	}
+}
+
+func TestAcronyms_capitalize(t *testing.T) {
+	tests := []struct {
+		w    string
+		want string
+	}{
+		{"", ""},
+		{"id", "ID"},
+		{"Id", "ID"},
+		{"ids", "IDs"},
+		{"IDS", "IDs"},
+		{"is", "Is"},
+		{"s", "S"},
+		{"url", "URL"},
+		{"urls", "URLs"},
+		{"user", "User"},
+		{"2ND", "2nd"},
+	}
+	for _, tt := range tests {
+		if got := DefaultAcronyms.capitalize(tt.w, cases.Title(language.Und), cases.Lower(language.Und)); got != tt.want {
+			t.Errorf("capitalize(%q) = %q, want %q", tt.w, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package strings
+
+import (
+	"fmt"
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// Alphabet is a set of distinct symbols that random strings are made from. A symbol is a rune,
+// or for an Alphabet made by NewGraphemeAlphabet, a grapheme cluster: what a reader sees as
+// one character, like "é" written as "e" and a combining accent, or an emoji with a skin tone.
+//
+// Unlike the source strings of RandomString and CryptoString, an Alphabet can hold any Unicode
+// characters, and each symbol is equally likely no matter how often it was repeated.
+type Alphabet struct {
+	symbols []string
+	ascii   string // the symbols joined together, if each is a single ASCII byte
+}
+
+// NewAlphabet returns an Alphabet of the distinct runes of source, in the order they first appear.
+//
+// If source is not valid UTF-8 or has fewer than two distinct runes, the error wraps ErrSyntax.
+func NewAlphabet(source string) (Alphabet, error) {
+	if !utf8.ValidString(source) {
+		return Alphabet{}, fmt.Errorf("alphabet %q: invalid UTF-8: %w", source, ErrSyntax)
+	}
+	var symbols []string
+	for _, r := range source {
+		symbols = append(symbols, string(r))
+	}
+	return newAlphabet(source, symbols)
+}
+
+// NewGraphemeAlphabet returns an Alphabet of the distinct grapheme clusters of source, in the order they
+// first appear. source is normalized to NFC first, so different ways of writing the same character
+// are the same symbol.
+//
+// If source is not valid UTF-8 or has fewer than two distinct grapheme clusters, the error wraps ErrSyntax.
+func NewGraphemeAlphabet(source string) (Alphabet, error) {
+	if !utf8.ValidString(source) {
+		return Alphabet{}, fmt.Errorf("alphabet %q: invalid UTF-8: %w", source, ErrSyntax)
+	}
+	var symbols []string
+	gr := uniseg.NewGraphemes(norm.NFC.String(source))
+	for gr.Next() {
+		symbols = append(symbols, gr.Str())
+	}
+	return newAlphabet(source, symbols)
+}
+
+func newAlphabet(source string, symbols []string) (Alphabet, error) {
+	var a Alphabet
+	seen := make(map[string]bool)
+	for _, s := range symbols {
+		if !seen[s] {
+			seen[s] = true
+			a.symbols = append(a.symbols, s)
+		}
+	}
+	if len(a.symbols) < 2 {
+		return Alphabet{}, fmt.Errorf("alphabet %q has %d distinct symbols, and needs at least 2: %w",
+			source, len(a.symbols), ErrSyntax)
+	}
+	if joined := strings.Join(a.symbols, ""); len(joined) == len(a.symbols) && isASCII(joined) {
+		a.ascii = joined
+	}
+	return a, nil
+}
+
+// MustAlphabet is like NewAlphabet, but panics if source is not a valid alphabet.
+// It is meant for alphabets in package variables.
+func MustAlphabet(source string) Alphabet {
+	a, err := NewAlphabet(source)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Len returns the number of symbols in a.
+func (a Alphabet) Len() int {
+	return len(a.symbols)
+}
+
+// Symbols returns the symbols of a, in order.
+func (a Alphabet) Symbols() []string {
+	return append([]string(nil), a.symbols...)
+}
+
+// Entropy returns the entropy in bits of a random string of n symbols from a.
+func (a Alphabet) Entropy(n int) float64 {
+	if len(a.symbols) == 0 {
+		return 0
+	}
+	return float64(n) * math.Log2(float64(len(a.symbols)))
+}
+
+// RandomString returns a pseudo random string of n symbols from a. See RandomString.
+func (a Alphabet) RandomString(n int) string {
+	s, _ := defaultGenerator.AlphabetString(a, n)
+	return s
+}
+
+// CryptoString returns a cryptographically secure random string of n symbols from a.
+// The error is from crypto/rand, and should never happen.
+func (a Alphabet) CryptoString(n int) (string, error) {
+	return cryptoGenerator.AlphabetString(a, n)
+}
+
+// AlphabetString returns a random string of n symbols from a, using the randomness of g.
+// Each symbol is equally likely to be picked. Alphabets of single ASCII characters are picked a byte at a time.
+//
+// If the reader of g returns an error, it is returned. It panics if a is the zero Alphabet.
+func (g *Generator) AlphabetString(a Alphabet, n int) (string, error) {
+	if len(a.symbols) == 0 {
+		panic("strings: empty alphabet")
+	}
+	if a.ascii != "" {
+		b := make([]byte, n)
+		if err := g.Fill(b, a.ascii); err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		j, err := g.intN(len(a.symbols))
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(a.symbols[j])
+	}
+	return b.String(), nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
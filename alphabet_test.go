@@ -0,0 +1,120 @@
+package strings
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func ExampleNewAlphabet() {
+	a, _ := NewAlphabet("αβγδεαβγ")
+	fmt.Println(a.Len(), utf8.RuneCountInString(a.RandomString(8)))
+	//Output: 5 8
+}
+
+func TestNewAlphabet(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		grapheme bool
+		want     []string
+		wantErr  bool
+	}{
+		{"ascii", "abcabc", false, []string{"a", "b", "c"}, false},
+		{"greek", "αβγα", false, []string{"α", "β", "γ"}, false},
+		{"emoji", "🍎🍐🍎", false, []string{"🍎", "🍐"}, false},
+		{"combining runes", "éa", false, []string{"e", "́", "a"}, false},
+		{"combining graphemes", "éaé", true, []string{"é", "a"}, false},
+		{"skin tone", "👍🏽👍", true, []string{"👍🏽", "👍"}, false},
+		{"flags", "🇫🇷🇯🇵🇫🇷", true, []string{"🇫🇷", "🇯🇵"}, false},
+		{"ascii grapheme", "ab\r\n", true, []string{"a", "b", "\r\n"}, false},
+		{"one symbol", "aaa", false, nil, true},
+		{"one grapheme", "éé", true, nil, true},
+		{"empty", "", false, nil, true},
+		{"invalid", "ab\xff", false, nil, true},
+		{"invalid grapheme", "ab\xff", true, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Alphabet
+			var err error
+			if tt.grapheme {
+				a, err = NewGraphemeAlphabet(tt.source)
+			} else {
+				a, err = NewAlphabet(tt.source)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, ErrSyntax) {
+					t.Errorf("error = %v, want ErrSyntax", err)
+				}
+				return
+			}
+			if err != nil || fmt.Sprint(a.Symbols()) != fmt.Sprint(tt.want) {
+				t.Errorf("Symbols() = %q, %v, want %q", a.Symbols(), err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlphabetString(t *testing.T) {
+	g := NewSeededGenerator(9)
+	for _, a := range []Alphabet{
+		MustAlphabet("ab"),
+		MustAlphabet("日本語中文"),
+		MustAlphabet("éèêë"),
+	} {
+		s, err := g.AlphabetString(a, 200)
+		if err != nil || !utf8.ValidString(s) || utf8.RuneCountInString(s) != 200 {
+			t.Fatalf("AlphabetString(%q) = %q, %v", a.Symbols(), s, err)
+		}
+		counts := map[rune]int{}
+		for _, r := range s {
+			counts[r]++
+		}
+		if len(counts) != a.Len() {
+			t.Errorf("AlphabetString(%q) used %d symbols", a.Symbols(), len(counts))
+		}
+	}
+
+	a, _ := NewGraphemeAlphabet("👍🏽👍🏿")
+	s, err := a.CryptoString(10)
+	if err != nil || utf8.RuneCountInString(s) != 20 {
+		t.Errorf("CryptoString() = %q, %v", s, err)
+	}
+
+	a, _ = NewGraphemeAlphabet("ab\r\n")
+	s, _ = g.AlphabetString(a, 200)
+	if n := strings.Count(s, "\r\n"); strings.Count(s, "\r") != n || strings.Count(s, "\n") != n || len(s)-n != 200 {
+		t.Errorf("AlphabetString(%q) = %q, which splits a symbol", a.Symbols(), s)
+	}
+
+	if _, err := NewGenerator(&countingReader{}).AlphabetString(MustAlphabet("αβγ"), 1); err == nil {
+		t.Error("AlphabetString() did not return the error of the reader")
+	}
+
+	if got := MustAlphabet(AlphaNumeric + AlphaNumeric).Entropy(10); math.Abs(got-Entropy(AlphaNumeric, 10)) > 1e-9 {
+		t.Errorf("Entropy() = %v, want %v", got, Entropy(AlphaNumeric, 10))
+	}
+}
+
+func TestRandomStringUnicode(t *testing.T) {
+	s := RandomString("é🍎", 50)
+	if !utf8.ValidString(s) || utf8.RuneCountInString(s) != 50 {
+		t.Errorf("RandomString() = %q", s)
+	}
+	s = CryptoString("日本", 5)
+	if !utf8.ValidString(s) || utf8.RuneCountInString(s) != 5 {
+		t.Errorf("CryptoString() = %q", s)
+	}
+}
+
+func BenchmarkAlphabetString(b *testing.B) {
+	a := MustAlphabet(AlphaNumeric)
+	g := cryptoGenerator
+	for i := 0; i < b.N; i++ {
+		_, _ = g.AlphabetString(a, 32)
+	}
+}
@@ -1,26 +1,23 @@
 package strings
 
 import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"slices"
 	"strings"
-	"unicode"
 )
 
-// KebabToCamel convert string s from kebab-case to CamelCase. The initial case of s does not affect the output.
+// KebabToCamel convert string s from kebab-case to CamelCase. The initial case of s does not affect the output,
+// except that words in DefaultAcronyms are put in upper case, so "user-id" becomes "UserID".
+//
+// Words are found using the same rules as Words, so any separator, and not just a hyphen, will start a new word.
 func KebabToCamel(s string) string {
-	// This depends on Map walking the string from start to end, which
-	// really needs to happen anyway in unicode strings.
-	capitalize := true
-	return strings.Map(func(r rune) rune {
-		if r == '-' {
-			capitalize = true
-			return -1
-		} else if capitalize {
-			capitalize = false
-			return unicode.ToTitle(r)
-		} else {
-			return unicode.ToLower(r)
-		}
-	}, s)
+	return KebabToCamelWith(s, CaseOptions{})
+}
+
+// KebabToCamelWith converts s from kebab-case to CamelCase using the given options.
+func KebabToCamelWith(s string, opts CaseOptions) string {
+	return ToCaseWith(s, PascalCase, opts)
 }
 
 // SnakeToKebab converts s from snake_case to kebab-case. Only the underscores are impacted, the case of the rest of s
@@ -30,140 +27,161 @@ func SnakeToKebab(s string) string {
 }
 
 // SnakeToCamel converts s from snake_case to CamelCase.
+// Words in DefaultAcronyms are put in upper case, so "user_id" becomes "UserID".
+//
+// Words are found using the same rules as Words, so any separator, and not just an underscore, will start a new word.
 func SnakeToCamel(s string) string {
-	k := SnakeToKebab(s)
-	return KebabToCamel(k)
+	return SnakeToCamelWith(s, CaseOptions{})
+}
+
+// SnakeToCamelWith converts s from snake_case to CamelCase using the given options.
+//
+//	SnakeToCamelWith("user_id", CaseOptions{Acronyms: Acronyms{}}) // UserId
+func SnakeToCamelWith(s string, opts CaseOptions) string {
+	return ToCaseWith(s, PascalCase, opts)
 }
 
 // CamelToKebab converts capitalize from CamelCase to kebab-case.
-// If it encounters a character that is not legitimate camel case,
-// it ignores it (like numbers, spaces, etc.).
 // Runs of upper case letters are treated as one word.
+// Numbers are kept with the word before them, so "HTTP2Server" becomes "http2-server".
+// Use CamelToKebabWith to give numbers their own words.
+// Any other character that is not legitimate camel case, like a space, is treated as a word separator.
 func CamelToKebab(camelCase string) string {
-	return camelToKebabOrSnake(camelCase, '-')
+	return CamelToKebabWith(camelCase, CaseOptions{})
+}
+
+// CamelToKebabWith converts camelCase from CamelCase to kebab-case using the given options.
+func CamelToKebabWith(camelCase string, opts CaseOptions) string {
+	return ToCaseWith(camelCase, KebabCase, opts)
 }
 
 // CamelToSnake converts camelCase from CamelCase to snake_case.
-// If it encounters a character that is not legitimate camel case,
-// it ignores it (like numbers, spaces, etc.).
 // Runs of upper case letters are treated as one word.
 // A run of upper case, followed by lower case letters will be treated
 // as if the final character in the upper case run belongs with the lower case
 // letters.
+// Numbers are kept with the word before them, so "Address2Line" becomes "address2_line".
+// Use CamelToSnakeWith to give numbers their own words.
+// Any other character that is not legitimate camel case, like a space, is treated as a word separator.
 func CamelToSnake(camelCase string) string {
-	return camelToKebabOrSnake(camelCase, '_')
-}
-
-func camelToKebabOrSnake(camelCase string, replacement rune) string {
-	var kebabCase []rune
-	var inUpper bool
-
-	for i, r := range camelCase {
-		if unicode.IsLetter(r) {
-			if unicode.IsUpper(r) {
-				if i > 0 && !inUpper {
-					kebabCase = append(kebabCase, replacement)
-				}
-				kebabCase = append(kebabCase, unicode.ToLower(r))
-				inUpper = true
-
-			} else {
-				if inUpper {
-					// switching from upper to lower, if we were in an upper run
-					// we need to add a hyphen in front of the last rune
-					if len(kebabCase) > 1 && kebabCase[len(kebabCase)-2] != replacement {
-						r2 := kebabCase[len(kebabCase)-1]
-						kebabCase[len(kebabCase)-1] = replacement
-						kebabCase = append(kebabCase, r2)
-					}
-				}
-				kebabCase = append(kebabCase, r)
-				inUpper = false
-			}
-		}
-	}
+	return CamelToSnakeWith(camelCase, CaseOptions{})
+}
 
-	return string(kebabCase)
+// CamelToSnakeWith converts camelCase from CamelCase to snake_case using the given options.
+//
+//	CamelToSnakeWith("Address2Line", CaseOptions{SplitDigits: true}) // address_2_line
+func CamelToSnakeWith(camelCase string, opts CaseOptions) string {
+	return ToCaseWith(camelCase, SnakeCase, opts)
 }
 
 // Decap returns a new string with the first character in the string set to its lower case equivalent,
 // and subsequent characters that are capitalized also set to lower case until it encounters a lower case letter.
 func Decap(s string) string {
-	if s == "" {
-		return ""
-	}
-	var b strings.Builder
-	var foundLower bool
+	return DecapLang(s, language.Und)
+}
+
+// DecapLang is like Decap, but uses the case mapping rules of the given language.
+//
+//	DecapLang("İlçeAdı", language.Turkish) // ilçeAdı
+func DecapLang(s string, tag language.Tag) string {
+	end := len(s)
 	for i, r := range s {
-		l := unicode.ToLower(r)
-		if i == 0 {
-			b.WriteRune(l)
-		} else if l != r && !foundLower {
-			b.WriteRune(l)
-		} else {
-			foundLower = true
-			b.WriteRune(r)
+		if i > 0 && classOf(r) != classUpper {
+			end = i
+			break
 		}
 	}
-	return b.String()
+	return cases.Lower(tag).String(s[:end]) + s[end:]
 }
 
 // Title is a more advanced titling operation. It will convert underscores to spaces, and add spaces to CamelCase
 // words.
+//
+// The words are found using Words, and each one has its first letter capitalized. The rest of each word is
+// left as is, so acronyms like "ID" are preserved. Words in DefaultAcronyms are put in upper case.
+//
+// Use TitleWith for language specific rules or headline style titles.
 func Title(s string) string {
-	s = strings.TrimSpace(strings.Title(strings.Replace(s, "_", " ", -1)))
-	if len(s) <= 1 {
-		return s
-	}
+	return TitleWith(s, TitleOptions{})
+}
+
+// HeadlineSmallWords are the English words that are usually left in lower case in headline style titles.
+var HeadlineSmallWords = []string{
+	"a", "an", "and", "as", "at", "but", "by", "for", "from", "if", "in", "into", "nor",
+	"of", "off", "on", "or", "per", "so", "the", "to", "up", "via", "vs", "with", "yet",
+}
 
-	newString := s[0:1]
-	l := strings.ToLower(s)
-	i := 1
-	for i < len(s) {
-		if l[i] != s[i] && s[i-1:i] != " " {
-			// is a capital.
-			newString += " "
-			// Group capitals until we get a lower case
-			for i < len(s)-1 && l[i] != s[i] && l[i+1] != s[i+1] {
-				newString += s[i : i+1]
-				i++
-			}
+// TitleOptions control how TitleWith capitalizes words.
+type TitleOptions struct {
+	// Language selects language specific capitalization rules, like capitalizing "ijssel" as "IJssel" in Dutch.
+	// The zero value uses rules that suit most languages.
+	Language language.Tag
+	// SmallWords are words that are put in lower case unless they are the first or last word of the title.
+	// Set it to HeadlineSmallWords for English headline style titles.
+	SmallWords []string
+	// Acronyms are kept in upper case. Nil means DefaultAcronyms.
+	Acronyms Acronyms
+}
+
+// TitleWith converts s to a title like Title does, but using the given options.
+//
+//	TitleWith("the_lord_of_the_rings", TitleOptions{SmallWords: HeadlineSmallWords}) // The Lord of the Rings
+func TitleWith(s string, opts TitleOptions) string {
+	words := Words(s)
+	acronyms := opts.Acronyms
+	if acronyms == nil {
+		acronyms = DefaultAcronyms
+	}
+	title := cases.Title(opts.Language, cases.NoLower)
+	lower := cases.Lower(opts.Language)
+	for i, w := range words {
+		if u := acronyms.upper(w); u != "" {
+			words[i] = u
+		} else if i > 0 && i < len(words)-1 && slices.ContainsFunc(opts.SmallWords, func(small string) bool {
+			return strings.EqualFold(small, w)
+		}) {
+			words[i] = lower.String(w)
+		} else if startsWithLetter(w) {
+			words[i] = title.String(w)
 		}
-		newString += s[i : i+1]
-		i++
 	}
-	return newString
+	return strings.Join(words, " ")
 }
 
 // Camel converts a string to camel case.
+//
+// The words are found using Words, and each one is capitalized and joined together.
+// Words in DefaultAcronyms are put in upper case.
 func Camel(s string) string {
-	s = strings.TrimSpace(strings.Title(strings.Replace(s, "_", " ", -1)))
-	if len(s) <= 1 {
-		return s
-	}
+	return ToCase(s, PascalCase)
+}
 
-	newString := s[0:1]
-	l := strings.ToLower(s)
-	for i := 1; i < len(s); i++ {
-		if l[i] != s[i] && s[i-1:i] != " " {
-			newString += " "
-		}
-		newString += s[i : i+1]
+// joinWords joins words with sep after passing each one through f.
+func joinWords(words []string, sep string, f func(string) string) string {
+	for i, w := range words {
+		words[i] = f(w)
 	}
-	return newString
+	return strings.Join(words, sep)
 }
 
 // EqualCaseInsensitive is a synonym for the strings package EqualFold which provides unicode compliant case-insensitive
 // comparison.
+//
+// EqualFold does not know about language specific rules. Use EqualCaseInsensitiveLang for those.
 func EqualCaseInsensitive(s1, s2 string) bool {
 	return strings.EqualFold(s1, s2)
 }
 
+// EqualCaseInsensitiveLang returns true if s1 and s2 are equal when case is ignored using the rules of the given language.
+// For example, in Turkish "I" matches "ı" but not "i", and in Greek the final form of sigma matches the other forms.
+func EqualCaseInsensitiveLang(s1, s2 string, tag language.Tag) bool {
+	lower := cases.Lower(tag)
+	fold := cases.Fold()
+	return fold.String(lower.String(s1)) == fold.String(lower.String(s2))
+}
+
+// IsSnake returns true if s is in snake_case. It must start with a letter, contain only lower case letters,
+// numbers and underscores, and not start or end with an underscore or have two underscores in a row.
 func IsSnake(s string) bool {
-	for _, r := range s {
-		if !((unicode.IsLetter(r) && unicode.IsLower(r)) || r == '_' || unicode.IsNumber(r)) {
-			return false
-		}
-	}
-	return true
+	return isJoined(s, "_", isLowerWord)
 }
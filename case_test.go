@@ -2,6 +2,7 @@ package strings
 
 import (
 	"fmt"
+	"golang.org/x/text/language"
 	"testing"
 )
 
@@ -94,7 +95,12 @@ func TestCamelToKebab(t *testing.T) {
 		{"Abc", "Abc", "abc"},
 		{"AbC", "AbC", "ab-c"},
 		{"ABc", "ABc", "a-bc"},
-		{"a1b", "a1b", "ab"},
+		{"a1b", "a1b", "a1b"},
+		{"A1B", "A1B", "a1-b"},
+		{"HTTP2Server", "HTTP2Server", "http2-server"},
+		{"userID", "userID", "user-id"},
+		{"UserIDs", "UserIDs", "user-ids"},
+		{"IDsFor", "IDsFor", "ids-for"},
 		{"ABC", "ABC", "abc"},
 		{"ABCd", "ABCd", "ab-cd"},
 		{"AbCdE", "ABCdE", "ab-cd-e"},
@@ -108,6 +114,31 @@ func TestCamelToKebab(t *testing.T) {
 	}
 }
 
+func TestCamelToSnakeWith(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		split bool
+		want  string
+	}{
+		{"empty", "", false, ""},
+		{"digit kept", "Address2Line", false, "address2_line"},
+		{"digit split", "Address2Line", true, "address_2_line"},
+		{"acronym kept", "HTTP2Server", false, "http2_server"},
+		{"acronym split", "HTTP2Server", true, "http_2_server"},
+		{"trailing kept", "Line12", false, "line12"},
+		{"trailing split", "Line12", true, "line_12"},
+		{"leading split", "12Lines", true, "12_lines"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CamelToSnakeWith(tt.s, CaseOptions{SplitDigits: tt.split}); got != tt.want {
+				t.Errorf("CamelToSnakeWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDecap(t *testing.T) {
 	if Decap("") != "" {
 		t.Fail()
@@ -118,7 +149,7 @@ func TestDecap(t *testing.T) {
 	if Decap("AbcDef") != "abcDef" {
 		t.Fail()
 	}
-	if Decap("Value") != "id" {
+	if Decap("ID") != "id" {
 		t.Fail()
 	}
 	if Decap("IDs") != "ids" {
@@ -151,6 +182,9 @@ func TestTitle(t *testing.T) {
 		{"ManagerID", "ManagerID", "Manager ID"},
 		{"BobTheGrocer", "BobTheGrocer", "Bob The Grocer"},
 		{"ILike Kiwis", "ILike Kiwis", "I Like Kiwis"},
+		{"unicode", "élan_vital", "Élan Vital"},
+		{"digits", "Address2Line", "Address2 Line"},
+		{"acronym", "user_id", "User ID"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -161,6 +195,28 @@ func TestTitle(t *testing.T) {
 	}
 }
 
+func TestCamel(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"i", "i", "I"},
+		{"snake", "do_i_seeYou", "DoISeeYou"},
+		{"spaces", " bob the grocer ", "BobTheGrocer"},
+		{"upper", "MANAGER_ID", "ManagerID"},
+		{"unicode", "élan_vital", "ÉlanVital"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Camel(tt.s); got != tt.want {
+				t.Errorf("Camel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestEqualCaseInsensitive(t *testing.T) {
 	tests := []struct {
 		name string
@@ -207,19 +263,19 @@ func TestIsSnake(t *testing.T) {
 			expected: false,
 		},
 		{
-			name:     "Contains only numbers",
+			name:     "Only numbers rejected",
 			input:    "12345",
-			expected: true,
+			expected: false,
 		},
 		{
 			name:     "Empty string",
 			input:    "",
-			expected: true,
+			expected: false,
 		},
 		{
 			name:     "Contains consecutive underscores",
 			input:    "snake__case",
-			expected: true,
+			expected: false,
 		},
 		{
 			name:     "Valid snake_case with numbers",
@@ -227,13 +283,43 @@ func TestIsSnake(t *testing.T) {
 			expected: true,
 		},
 		{
-			name:     "Valid with trailing underscore",
+			name:     "Trailing underscore rejected",
 			input:    "snake_case_",
-			expected: true,
+			expected: false,
 		},
 		{
 			name:     "Single underscore",
 			input:    "_",
+			expected: false,
+		},
+		{
+			name:     "Double underscore",
+			input:    "__",
+			expected: false,
+		},
+		{
+			name:     "Leading number",
+			input:    "1abc",
+			expected: false,
+		},
+		{
+			name:     "Leading underscore",
+			input:    "_snake",
+			expected: false,
+		},
+		{
+			name:     "Single word",
+			input:    "snake",
+			expected: true,
+		},
+		{
+			name:     "Split digits",
+			input:    "address_2_line",
+			expected: true,
+		},
+		{
+			name:     "Unicode",
+			input:    "élan_vital",
 			expected: true,
 		},
 	}
@@ -264,6 +350,11 @@ func TestSnakeToCamel(t *testing.T) {
 		{"__multiple__underscores__", "MultipleUnderscores"},
 		{"with_numbers_123", "WithNumbers123"},
 		{"a", "A"},
+		{"user_id", "UserID"},
+		{"user_ids", "UserIDs"},
+		{"http_url", "HTTPURL"},
+		{"api_key_json", "APIKeyJSON"},
+		{"identity", "Identity"},
 	}
 
 	for _, tt := range tests {
@@ -273,3 +364,95 @@ func TestSnakeToCamel(t *testing.T) {
 		}
 	}
 }
+
+func TestSnakeToCamelWith(t *testing.T) {
+	if got := SnakeToCamelWith("user_id", CaseOptions{Acronyms: Acronyms{}}); got != "UserId" {
+		t.Errorf("SnakeToCamelWith() with no acronyms = %v, want UserId", got)
+	}
+	if got := SnakeToCamelWith("sku_code", CaseOptions{Acronyms: NewAcronyms("sku")}); got != "SKUCode" {
+		t.Errorf("SnakeToCamelWith() with custom acronyms = %v, want SKUCode", got)
+	}
+	if got := KebabToCamelWith("user-id", CaseOptions{}); got != "UserID" {
+		t.Errorf("KebabToCamelWith() = %v, want UserID", got)
+	}
+}
+
+func ExampleTitleWith() {
+	fmt.Println(TitleWith("the_lord_of_the_rings", TitleOptions{SmallWords: HeadlineSmallWords}))
+	fmt.Println(TitleWith("ijssel_meer", TitleOptions{Language: language.Dutch}))
+	//Output: The Lord of the Rings
+	//IJssel Meer
+}
+
+func TestTitleWith(t *testing.T) {
+	headline := TitleOptions{SmallWords: HeadlineSmallWords}
+	tests := []struct {
+		name string
+		s    string
+		opts TitleOptions
+		want string
+	}{
+		{"empty", "", headline, ""},
+		{"small first and last", "of mice and of", headline, "Of Mice and Of"},
+		{"small upper", "GoneWithTheWind", headline, "Gone with the Wind"},
+		{"no small words", "gone_with_the_wind", TitleOptions{}, "Gone With The Wind"},
+		{"acronym", "a_url_for_the_api", headline, "A URL for the API"},
+		{"multibyte", "élan_vital", headline, "Élan Vital"},
+		{"cjk", "日本語_text", headline, "日本語 Text"},
+		{"leading digit", "2nd_place", headline, "2nd Place"},
+		{"greek", "όνομα_χρήστη", headline, "Όνομα Χρήστη"},
+		{"dutch", "ijssel", TitleOptions{Language: language.Dutch}, "IJssel"},
+		{"english ij", "ijssel", TitleOptions{Language: language.English}, "Ijssel"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TitleWith(tt.s, tt.opts); got != tt.want {
+				t.Errorf("TitleWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecapLang(t *testing.T) {
+	tests := []struct {
+		s    string
+		tag  language.Tag
+		want string
+	}{
+		{"", language.Turkish, ""},
+		{"İlçeAdı", language.Turkish, "ilçeAdı"},
+		{"IRMAK", language.Turkish, "ırmak"},
+		{"IRMAK", language.English, "irmak"},
+		{"IDsFor", language.Und, "idsFor"},
+	}
+	for _, tt := range tests {
+		if got := DecapLang(tt.s, tt.tag); got != tt.want {
+			t.Errorf("DecapLang(%q, %v) = %q, want %q", tt.s, tt.tag, got, tt.want)
+		}
+	}
+}
+
+func TestEqualCaseInsensitiveLang(t *testing.T) {
+	tests := []struct {
+		name   string
+		s1, s2 string
+		tag    language.Tag
+		want   bool
+	}{
+		{"match", "aBc", "Abc", language.Und, true},
+		{"nomatch", "aBd", "Abc", language.Und, false},
+		{"turkish dotless", "IRMAK", "ırmak", language.Turkish, true},
+		{"turkish dotted", "İSTANBUL", "istanbul", language.Turkish, true},
+		{"turkish mismatch", "IRMAK", "irmak", language.Turkish, false},
+		{"english", "IRMAK", "irmak", language.English, true},
+		{"greek final sigma", "ΟΔΟΣ", "οδος", language.Greek, true},
+		{"greek sigma forms", "οδοσ", "οδος", language.Greek, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualCaseInsensitiveLang(tt.s1, tt.s2, tt.tag); got != tt.want {
+				t.Errorf("EqualCaseInsensitiveLang() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package strings
+
+import (
+	"golang.org/x/text/cases"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// CaseStyle is a way of writing a name made of several words.
+type CaseStyle int
+
+const (
+	// UnknownCase is not a style. DetectCase returns it for strings that mix styles or follow none,
+	// and ToCase returns its input unchanged when given it.
+	UnknownCase CaseStyle = iota
+	// PascalCase capitalizes each word and joins them, like "UserID". This package also calls this CamelCase.
+	PascalCase
+	// LowerCamelCase is like PascalCase, but the first word is in lower case, like "userID".
+	LowerCamelCase
+	// SnakeCase joins lower case words with underscores, like "user_id".
+	SnakeCase
+	// ScreamingSnakeCase joins upper case words with underscores, like "USER_ID".
+	ScreamingSnakeCase
+	// KebabCase joins lower case words with hyphens, like "user-id".
+	KebabCase
+	// TrainCase joins capitalized words with hyphens, like "User-ID".
+	TrainCase
+	// DotCase joins lower case words with dots, like "user.id".
+	DotCase
+	// PathCase joins lower case words with slashes, like "user/id".
+	PathCase
+	// FlatCase joins lower case words with nothing in between, like "userid".
+	FlatCase
+	// TitleCase joins capitalized words with spaces, like "User ID".
+	TitleCase
+)
+
+var caseStyleNames = [...]string{
+	UnknownCase:        "unknown",
+	PascalCase:         "PascalCase",
+	LowerCamelCase:     "lowerCamelCase",
+	SnakeCase:          "snake_case",
+	ScreamingSnakeCase: "SCREAMING_SNAKE_CASE",
+	KebabCase:          "kebab-case",
+	TrainCase:          "Train-Case",
+	DotCase:            "dot.case",
+	PathCase:           "path/case",
+	FlatCase:           "flatcase",
+	TitleCase:          "Title Case",
+}
+
+// String returns the name of the style, written in that style.
+func (c CaseStyle) String() string {
+	if c < 0 || int(c) >= len(caseStyleNames) {
+		return caseStyleNames[UnknownCase]
+	}
+	return caseStyleNames[c]
+}
+
+// ToCase converts s to the given style. Words in s are found using Words, so s can be in any style,
+// and words in DefaultAcronyms are kept in upper case in the styles that capitalize words.
+//
+//	ToCase("userID", ScreamingSnakeCase) // USER_ID
+//	ToCase("user-id", PascalCase) // UserID
+func ToCase(s string, style CaseStyle) string {
+	return ToCaseWith(s, style, CaseOptions{})
+}
+
+// ToCaseWith converts s to the given style using the given options.
+//
+//	ToCaseWith("istanbul_il", PascalCase, CaseOptions{Language: language.Turkish}) // İstanbulİl
+func ToCaseWith(s string, style CaseStyle, opts CaseOptions) string {
+	words := WordsWith(s, opts)
+	acronyms := opts.acronyms()
+	lower := cases.Lower(opts.Language)
+	upper := cases.Upper(opts.Language)
+	title := cases.Title(opts.Language)
+	capitalize := func(w string) string {
+		return acronyms.capitalize(w, title, lower)
+	}
+	switch style {
+	case PascalCase:
+		return joinWords(words, "", capitalize)
+	case LowerCamelCase:
+		if len(words) == 0 {
+			return ""
+		}
+		first := lower.String(words[0])
+		return first + joinWords(words[1:], "", capitalize)
+	case SnakeCase:
+		return lower.String(strings.Join(words, "_"))
+	case ScreamingSnakeCase:
+		return upper.String(strings.Join(words, "_"))
+	case KebabCase:
+		return lower.String(strings.Join(words, "-"))
+	case TrainCase:
+		return joinWords(words, "-", capitalize)
+	case DotCase:
+		return lower.String(strings.Join(words, "."))
+	case PathCase:
+		return lower.String(strings.Join(words, "/"))
+	case FlatCase:
+		return lower.String(strings.Join(words, ""))
+	case TitleCase:
+		return joinWords(words, " ", capitalize)
+	}
+	return s
+}
+
+// DetectCase returns the style s is written in, or UnknownCase if s does not strictly follow one of the styles.
+//
+// Some strings are valid in more than one style. A single lower case word, like "name", is reported as FlatCase,
+// a single upper case word, like "ID", as ScreamingSnakeCase, and a single capitalized word, like "Name", as PascalCase.
+// Use the Is functions, like IsSnake, to check whether a string is valid in a particular style.
+func DetectCase(s string) CaseStyle {
+	switch {
+	case s == "":
+		return UnknownCase
+	case strings.Contains(s, "_"):
+		if IsSnake(s) {
+			return SnakeCase
+		} else if IsScreamingSnake(s) {
+			return ScreamingSnakeCase
+		}
+	case strings.Contains(s, "-"):
+		if IsKebab(s) {
+			return KebabCase
+		} else if IsTrain(s) {
+			return TrainCase
+		}
+	case strings.Contains(s, "."):
+		if isJoined(s, ".", isLowerWord) {
+			return DotCase
+		}
+	case strings.Contains(s, "/"):
+		if isJoined(s, "/", isLowerWord) {
+			return PathCase
+		}
+	case strings.Contains(s, " "):
+		if isJoined(s, " ", isCapitalWord) {
+			return TitleCase
+		}
+	case isJoined(s, "", isLowerWord):
+		return FlatCase
+	case isJoined(s, "", isUpperWord):
+		return ScreamingSnakeCase
+	case IsCamel(s):
+		return LowerCamelCase
+	case IsPascal(s):
+		return PascalCase
+	}
+	return UnknownCase
+}
+
+// IsCamel returns true if s is in lowerCamelCase. It must start with a lower case letter,
+// and contain only letters and numbers.
+//
+// Note that the Camel functions in this package, like SnakeToCamel, produce PascalCase, which IsPascal checks.
+func IsCamel(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return classOf(r) == classLower && isJoined(s, "", isAlphaNumericWord)
+}
+
+// IsPascal returns true if s is in PascalCase. It must start with an upper case letter,
+// and contain only letters and numbers.
+func IsPascal(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return classOf(r) == classUpper && isJoined(s, "", isAlphaNumericWord)
+}
+
+// IsKebab returns true if s is in kebab-case. It must start with a letter, contain only lower case letters,
+// numbers and hyphens, and not start or end with a hyphen or have two hyphens in a row.
+func IsKebab(s string) bool {
+	return isJoined(s, "-", isLowerWord)
+}
+
+// IsScreamingSnake returns true if s is in SCREAMING_SNAKE_CASE. It must start with a letter, contain only
+// upper case letters, numbers and underscores, and not start or end with an underscore or have
+// two underscores in a row.
+func IsScreamingSnake(s string) bool {
+	return isJoined(s, "_", isUpperWord)
+}
+
+// IsTrain returns true if s is in Train-Case. It must start with a letter, and be made of words joined by
+// single hyphens. Each word must start with an upper case letter or a number, and be followed by either
+// all lower case letters or, for acronyms, all upper case letters.
+func IsTrain(s string) bool {
+	return isJoined(s, "-", isCapitalWord)
+}
+
+// isJoined returns true if s starts with a letter and is made of non-empty words joined by sep that all pass ok.
+// An empty sep means s is a single word.
+func isJoined(s string, sep string, ok func(w string) bool) bool {
+	if !startsWithLetter(s) {
+		return false
+	}
+	if sep == "" {
+		return ok(s)
+	}
+	for _, w := range strings.Split(s, sep) {
+		if w == "" || !ok(w) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasOnly returns true if every rune in w is one of the given classes, or is a mark.
+func hasOnly(w string, classes ...runeClass) bool {
+	for _, r := range w {
+		c := classOf(r)
+		if c != classMark && !slices.Contains(classes, c) {
+			return false
+		}
+	}
+	return true
+}
+
+func isLowerWord(w string) bool {
+	return hasOnly(w, classLower, classDigit)
+}
+
+func isUpperWord(w string) bool {
+	return hasOnly(w, classUpper, classDigit)
+}
+
+func isAlphaNumericWord(w string) bool {
+	return hasOnly(w, classUpper, classLower, classDigit)
+}
+
+// isCapitalWord returns true if w starts with an upper case letter or number, and the rest is either
+// all lower case or all upper case.
+func isCapitalWord(w string) bool {
+	r, size := utf8.DecodeRuneInString(w)
+	if c := classOf(r); c != classUpper && c != classDigit {
+		return false
+	}
+	return isLowerWord(w[size:]) || isUpperWord(w[size:])
+}
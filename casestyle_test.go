@@ -0,0 +1,177 @@
+package strings
+
+import (
+	"fmt"
+	"golang.org/x/text/language"
+	"testing"
+)
+
+func ExampleToCase() {
+	for _, style := range []CaseStyle{PascalCase, LowerCamelCase, ScreamingSnakeCase, TrainCase, PathCase} {
+		fmt.Println(ToCase("user_id_field", style))
+	}
+	//Output: UserIDField
+	//userIDField
+	//USER_ID_FIELD
+	//User-ID-Field
+	//user/id/field
+}
+
+func TestToCase(t *testing.T) {
+	tests := []struct {
+		s     string
+		style CaseStyle
+		want  string
+	}{
+		{"", PascalCase, ""},
+		{"", LowerCamelCase, ""},
+		{"http_server_url", PascalCase, "HTTPServerURL"},
+		{"HTTPServerURL", LowerCamelCase, "httpServerURL"},
+		{"UserID", LowerCamelCase, "userID"},
+		{"user", LowerCamelCase, "user"},
+		{"HTTPServerURL", SnakeCase, "http_server_url"},
+		{"userID", ScreamingSnakeCase, "USER_ID"},
+		{"Address2Line", ScreamingSnakeCase, "ADDRESS2_LINE"},
+		{"content type", KebabCase, "content-type"},
+		{"content_type", TrainCase, "Content-Type"},
+		{"x-api-key", TrainCase, "X-API-Key"},
+		{"ServerConfig.Port", DotCase, "server.config.port"},
+		{"UserProfileImage", PathCase, "user/profile/image"},
+		{"user_profile", FlatCase, "userprofile"},
+		{"bobTheGrocer", TitleCase, "Bob The Grocer"},
+		{"élan_vital", PascalCase, "ÉlanVital"},
+		{"address_2nd_line", PascalCase, "Address2ndLine"},
+		{"as is", UnknownCase, "as is"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.style.String()+" "+tt.s, func(t *testing.T) {
+			if got := ToCase(tt.s, tt.style); got != tt.want {
+				t.Errorf("ToCase(%q, %v) = %q, want %q", tt.s, tt.style, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCaseStyle_String(t *testing.T) {
+	if got := SnakeCase.String(); got != "snake_case" {
+		t.Errorf("SnakeCase.String() = %q", got)
+	}
+	if got := CaseStyle(100).String(); got != "unknown" {
+		t.Errorf("CaseStyle(100).String() = %q", got)
+	}
+}
+
+func ExampleDetectCase() {
+	fmt.Println(DetectCase("user_id"))
+	fmt.Println(DetectCase("X-API-Key"))
+	fmt.Println(DetectCase("user_ID"))
+	//Output: snake_case
+	//Train-Case
+	//unknown
+}
+
+func TestDetectCase(t *testing.T) {
+	tests := []struct {
+		s    string
+		want CaseStyle
+	}{
+		{"", UnknownCase},
+		{"user_id", SnakeCase},
+		{"USER_ID", ScreamingSnakeCase},
+		{"ID", ScreamingSnakeCase},
+		{"user-id", KebabCase},
+		{"User-Id", TrainCase},
+		{"Content-Type", TrainCase},
+		{"user.id", DotCase},
+		{"user/id", PathCase},
+		{"userid", FlatCase},
+		{"user2", FlatCase},
+		{"userID", LowerCamelCase},
+		{"UserID", PascalCase},
+		{"User", PascalCase},
+		{"Bob The Grocer", TitleCase},
+		{"user_ID", UnknownCase},
+		{"user-ID", UnknownCase},
+		{"user_id-name", UnknownCase},
+		{"__", UnknownCase},
+		{"1abc", UnknownCase},
+		{"_user", UnknownCase},
+		{"user__id", UnknownCase},
+		{"user id", UnknownCase},
+		{"user$", UnknownCase},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := DetectCase(tt.s); got != tt.want {
+				t.Errorf("DetectCase(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCasePredicates(t *testing.T) {
+	tests := []struct {
+		s                                           string
+		camel, pascal, kebab, screamingSnake, train bool
+	}{
+		{"", false, false, false, false, false},
+		{"user", true, false, true, false, false},
+		{"userID", true, false, false, false, false},
+		{"UserID", false, true, false, false, false},
+		{"User", false, true, false, false, true},
+		{"USER", false, true, false, true, true},
+		{"user-id", false, false, true, false, false},
+		{"user--id", false, false, false, false, false},
+		{"-user", false, false, false, false, false},
+		{"USER_ID2", false, false, false, true, false},
+		{"USER__ID", false, false, false, false, false},
+		{"X-API-Key", false, false, false, false, true},
+		{"X-Api-KEy", false, false, false, false, false},
+		{"1User", false, false, false, false, false},
+		{"user_id", false, false, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := IsCamel(tt.s); got != tt.camel {
+				t.Errorf("IsCamel(%q) = %v", tt.s, got)
+			}
+			if got := IsPascal(tt.s); got != tt.pascal {
+				t.Errorf("IsPascal(%q) = %v", tt.s, got)
+			}
+			if got := IsKebab(tt.s); got != tt.kebab {
+				t.Errorf("IsKebab(%q) = %v", tt.s, got)
+			}
+			if got := IsScreamingSnake(tt.s); got != tt.screamingSnake {
+				t.Errorf("IsScreamingSnake(%q) = %v", tt.s, got)
+			}
+			if got := IsTrain(tt.s); got != tt.train {
+				t.Errorf("IsTrain(%q) = %v", tt.s, got)
+			}
+		})
+	}
+}
+
+func TestToCaseWith_Language(t *testing.T) {
+	tr := CaseOptions{Language: language.Turkish}
+	tests := []struct {
+		s     string
+		style CaseStyle
+		opts  CaseOptions
+		want  string
+	}{
+		{"istanbul_il", PascalCase, tr, "İstanbulİl"},
+		{"istanbul_il", PascalCase, CaseOptions{}, "IstanbulIl"},
+		{"ISTANBUL_IL", SnakeCase, tr, "ıstanbul_ıl"},
+		{"istanbul_il", ScreamingSnakeCase, tr, "İSTANBUL_İL"},
+		{"ΟΔΟΣ_ΟΝΟΜΑ", SnakeCase, CaseOptions{Language: language.Greek}, "οδος_ονομα"},
+		{"ΟΔΟΣ_ΟΝΟΜΑ", PascalCase, CaseOptions{Language: language.Greek}, "ΟδοςΟνομα"},
+		{"user_id", PascalCase, tr, "UserID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := ToCaseWith(tt.s, tt.style, tt.opts); got != tt.want {
+				t.Errorf("ToCaseWith(%q, %v) = %q, want %q", tt.s, tt.style, got, tt.want)
+			}
+		})
+	}
+}
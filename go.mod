@@ -5,3 +5,7 @@ go 1.23.2
 require github.com/gedex/inflector v0.0.0-20170307190818-16278e9db813
 
 require golang.org/x/exp v0.0.0-20241108190413-2d47ceb2692f
+
+require golang.org/x/text v0.21.0
+
+require github.com/rivo/uniseg v0.4.7
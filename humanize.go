@@ -0,0 +1,322 @@
+package strings
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ByteUnits selects the units used to format byte sizes.
+type ByteUnits int
+
+const (
+	// SI units are powers of 1000: kB, MB, GB, TB, PB and EB.
+	SI ByteUnits = iota
+	// IEC units are powers of 1024: KiB, MiB, GiB, TiB, PiB and EiB.
+	IEC
+)
+
+var siByteUnits = []string{"B", "kB", "MB", "GB", "TB", "PB", "EB"}
+var iecByteUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+// byteMultipliers maps the lower case form of each unit ParseBytes accepts to its size in bytes.
+var byteMultipliers = map[string]float64{
+	"": 1, "b": 1, "byte": 1, "bytes": 1,
+	"k": 1e3, "kb": 1e3, "m": 1e6, "mb": 1e6, "g": 1e9, "gb": 1e9,
+	"t": 1e12, "tb": 1e12, "p": 1e15, "pb": 1e15, "e": 1e18, "eb": 1e18,
+	"kib": 1 << 10, "mib": 1 << 20, "gib": 1 << 30, "tib": 1 << 40, "pib": 1 << 50, "eib": 1 << 60,
+}
+
+// FormatBytes writes the byte size n in the largest of the given units that keeps the number at least 1,
+// with up to one digit after the decimal point, like "1.5 KiB" for FormatBytes(1536, IEC) or "2 MB" for
+// FormatBytes(2_000_000, SI).
+func FormatBytes(n int64, units ByteUnits) string {
+	return FormatBytesPrecision(n, units, 1)
+}
+
+// FormatBytesPrecision is like FormatBytes, but writes up to precision digits after the decimal point.
+// Zeros at the end of the fraction are left off.
+func FormatBytesPrecision(n int64, units ByteUnits, precision int) string {
+	names, base := siByteUnits, 1000.0
+	if units == IEC {
+		names, base = iecByteUnits, 1024.0
+	}
+	v := math.Abs(float64(n))
+	i := 0
+	for ; i < len(names)-1 && v >= base; i++ {
+		v /= base
+	}
+	// rounding can carry the value up to the next unit, like 999.99 kB to 1000 kB
+	if r := roundTo(v, precision); r >= base && i < len(names)-1 {
+		v /= base
+		i++
+	}
+	s := strconv.FormatFloat(roundTo(v, precision), 'f', max(precision, 0), 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if n < 0 {
+		s = "-" + s
+	}
+	return s + " " + names[i]
+}
+
+func roundTo(v float64, precision int) float64 {
+	p := math.Pow10(precision)
+	return math.Round(v*p) / p
+}
+
+// ParseBytes parses a byte size, like "2.5GB", "512 KiB" or "100", into a number of bytes.
+//
+// The unit is not case-sensitive, and can be left off for bytes. SI units like "kB" and "MB", and their
+// single letter forms "k" and "M", are powers of 1000. IEC units like "KiB" and "MiB" are powers of 1024.
+// The result is rounded to the nearest byte.
+//
+// If s is not a byte size, the error wraps ErrSyntax. If it is too large for an int64, the error wraps ErrRange.
+func ParseBytes(s string) (int64, error) {
+	t := strings.TrimSpace(s)
+	i := strings.IndexFunc(t, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsSpace(r)
+	})
+	if i < 0 {
+		i = len(t)
+	}
+	mult, ok := byteMultipliers[strings.ToLower(strings.TrimSpace(t[i:]))]
+	if !ok {
+		return 0, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+	}
+	v, err := strconv.ParseFloat(t[:i], 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+	}
+	v = math.Round(v * mult)
+	if v >= math.MaxInt64 || v < math.MinInt64 {
+		return 0, fmt.Errorf("parsing %q: %w", s, ErrRange)
+	}
+	return int64(v), nil
+}
+
+// durationUnit is a named length of time.
+type durationUnit struct {
+	name string
+	d    time.Duration
+}
+
+var durationUnits = []durationUnit{
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+}
+
+// durationNames maps the units ParseDuration accepts to their length.
+var durationNames = map[string]time.Duration{
+	"ns": time.Nanosecond, "us": time.Microsecond, "µs": time.Microsecond, "μs": time.Microsecond, "ms": time.Millisecond,
+	"s": time.Second, "sec": time.Second, "secs": time.Second, "second": time.Second, "seconds": time.Second,
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	"d": 24 * time.Hour, "day": 24 * time.Hour, "days": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour, "wk": 7 * 24 * time.Hour, "week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour,
+}
+
+// relativeNames are the units ParseRelative accepts: those of ParseDuration, plus the months and years
+// that FormatRelative writes.
+var relativeNames = func() map[string]time.Duration {
+	m := map[string]time.Duration{
+		"month": 30 * 24 * time.Hour, "months": 30 * 24 * time.Hour,
+		"year": 365 * 24 * time.Hour, "years": 365 * 24 * time.Hour,
+	}
+	for k, v := range durationNames {
+		m[k] = v
+	}
+	return m
+}()
+
+// FormatDuration writes d in a compact form, like "1h 5m" or "2d 3h 10s". Parts that are zero are left out.
+// Durations of a second or more are rounded to the nearest second. Shorter durations are written the way
+// time.Duration writes them, like "250ms".
+func FormatDuration(d time.Duration) string {
+	if d < 0 {
+		return "-" + formatDuration(magnitude(int64(d)))
+	}
+	return formatDuration(uint64(d))
+}
+
+// formatDuration writes a duration of n nanoseconds. It works on the magnitude of a duration, so that
+// math.MinInt64 can be written.
+func formatDuration(n uint64) string {
+	const second = uint64(time.Second)
+	if n < second {
+		return time.Duration(n).String()
+	}
+	n = (n + second/2) / second * second
+	var parts []string
+	for _, u := range durationUnits {
+		if n >= uint64(u.d) {
+			parts = append(parts, strconv.FormatUint(n/uint64(u.d), 10)+u.name)
+			n %= uint64(u.d)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// ParseDuration parses a duration written as a series of numbers and units, like "1h 5m", "1h5m", "1.5 hours"
+// or "2 days 3 hours". It accepts everything time.ParseDuration does, plus spaces, days, weeks and the
+// long names of units. Like time.ParseDuration, it is exact to the nanosecond. Days are always 24 hours.
+// The duration may start with a sign.
+//
+// If s is not a duration, the error wraps ErrSyntax. If it is too long for a time.Duration, the error wraps ErrRange.
+func ParseDuration(s string) (time.Duration, error) {
+	return parseDuration(s, durationNames)
+}
+
+// parseDuration parses a duration with the given unit names.
+func parseDuration(s string, names map[string]time.Duration) (time.Duration, error) {
+	t := strings.TrimSpace(s)
+	neg := strings.HasPrefix(t, "-")
+	if neg {
+		t = t[1:]
+	} else {
+		t = strings.TrimPrefix(t, "+")
+	}
+	if t == "0" {
+		return 0, nil
+	} else if t == "" {
+		return 0, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+	}
+	// The total is summed in whole nanoseconds, so long durations keep their precision.
+	limit := uint64(math.MaxInt64)
+	if neg {
+		limit++
+	}
+	var total uint64
+	for t != "" {
+		i := strings.IndexFunc(t, func(r rune) bool {
+			return !(r >= '0' && r <= '9' || r == '.')
+		})
+		if i < 0 {
+			i = len(t)
+		}
+		whole, frac, _ := strings.Cut(t[:i], ".")
+		if whole == "" && frac == "" || strings.Contains(frac, ".") {
+			return 0, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+		}
+		t = strings.TrimLeft(t[i:], " ")
+		j := strings.IndexFunc(t, func(r rune) bool {
+			return !unicode.IsLetter(r)
+		})
+		if j < 0 {
+			j = len(t)
+		}
+		unit, ok := names[strings.ToLower(t[:j])]
+		if !ok {
+			return 0, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+		}
+		v, ok := durationPart(whole, frac, uint64(unit))
+		if !ok || v > limit-total {
+			return 0, fmt.Errorf("parsing %q: %w", s, ErrRange)
+		}
+		total += v
+		t = strings.TrimLeft(strings.TrimSpace(t[j:]), ",")
+		t = strings.TrimSpace(strings.TrimPrefix(t, "and "))
+	}
+	if neg {
+		return time.Duration(-total), nil
+	}
+	return time.Duration(total), nil
+}
+
+// durationPart returns the number of nanoseconds in a number of units, given as the digits before and after
+// the decimal point. Digits after the decimal point that are too small to matter are ignored, and the fraction
+// is rounded down, like time.ParseDuration does. ok is false if the result does not fit in a uint64.
+func durationPart(whole, frac string, unit uint64) (v uint64, ok bool) {
+	for i := 0; i < len(whole); i++ {
+		d := uint64(whole[i] - '0')
+		if v > (math.MaxUint64-d)/10 {
+			return 0, false
+		}
+		v = v*10 + d
+	}
+	if v > math.MaxUint64/unit {
+		return 0, false
+	}
+	v *= unit
+	var f uint64
+	scale := 1.0
+	for i := 0; i < len(frac) && f <= (math.MaxInt64-9)/10; i++ {
+		f = f*10 + uint64(frac[i]-'0')
+		scale *= 10
+	}
+	if f > 0 {
+		ns := uint64(float64(f) * (float64(unit) / scale))
+		if v > math.MaxUint64-ns {
+			return 0, false
+		}
+		v += ns
+	}
+	return v, true
+}
+
+// relativeUnits are the units FormatRelative uses, from largest to smallest.
+var relativeUnits = []durationUnit{
+	{"year", 365 * 24 * time.Hour},
+	{"month", 30 * 24 * time.Hour},
+	{"week", 7 * 24 * time.Hour},
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+}
+
+// FormatRelative describes when t is compared to now, like "3 days ago", "in 2 hours" or "just now".
+// The largest unit that fits is used, and the number is rounded down, so 47 hours ago is "1 day ago".
+// Times less than a minute from now are "just now". Months are 30 days and years are 365 days.
+func FormatRelative(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+	for _, u := range relativeUnits {
+		if d >= u.d {
+			n := int64(d / u.d)
+			s := strconv.FormatInt(n, 10) + " " + u.name
+			if n != 1 {
+				s += "s"
+			}
+			if future {
+				return "in " + s
+			}
+			return s + " ago"
+		}
+	}
+	return "just now"
+}
+
+// ParseRelative parses a relative time, like the ones FormatRelative writes, and returns the time it refers to
+// compared to now. The amount can be anything ParseDuration accepts, like "in 1h 30m" or "2 days ago",
+// and can also be in months of 30 days and years of 365 days. "now" and "just now" return now.
+//
+// If s is not a relative time, the error wraps ErrSyntax.
+func ParseRelative(s string, now time.Time) (time.Time, error) {
+	t := strings.ToLower(strings.TrimSpace(s))
+	switch {
+	case t == "now" || t == "just now":
+		return now, nil
+	case strings.HasSuffix(t, " ago"):
+		d, err := parseDuration(strings.TrimSuffix(t, " ago"), relativeNames)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+		}
+		return now.Add(-d), nil
+	case strings.HasPrefix(t, "in "):
+		d, err := parseDuration(strings.TrimPrefix(t, "in "), relativeNames)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+		}
+		return now.Add(d), nil
+	}
+	return time.Time{}, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+}
@@ -0,0 +1,230 @@
+package strings
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func ExampleFormatBytes() {
+	fmt.Println(FormatBytes(1536, IEC))
+	fmt.Println(FormatBytes(2_500_000_000, SI))
+	n, _ := ParseBytes("2.5GB")
+	fmt.Println(n)
+	//Output: 1.5 KiB
+	//2.5 GB
+	//2500000000
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n         int64
+		units     ByteUnits
+		precision int
+		want      string
+	}{
+		{0, SI, 1, "0 B"},
+		{999, SI, 1, "999 B"},
+		{1000, SI, 1, "1 kB"},
+		{1000, IEC, 1, "1000 B"},
+		{1024, IEC, 1, "1 KiB"},
+		{1536, IEC, 1, "1.5 KiB"},
+		{1536, IEC, 0, "2 KiB"},
+		{1_234_567, SI, 2, "1.23 MB"},
+		{1_234_567, SI, 3, "1.235 MB"},
+		{999_999, SI, 1, "1 MB"},
+		{1 << 30, IEC, 1, "1 GiB"},
+		{-1536, IEC, 1, "-1.5 KiB"},
+		{math.MaxInt64, IEC, 1, "8 EiB"},
+		{math.MaxInt64, SI, 2, "9.22 EB"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.n), func(t *testing.T) {
+			if got := FormatBytesPrecision(tt.n, tt.units, tt.precision); got != tt.want {
+				t.Errorf("FormatBytesPrecision(%d, %v, %d) = %q, want %q", tt.n, tt.units, tt.precision, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		s         string
+		want      int64
+		wantError error
+	}{
+		{"100", 100, nil},
+		{"100B", 100, nil},
+		{"100 bytes", 100, nil},
+		{"2.5GB", 2_500_000_000, nil},
+		{"2.5 gb", 2_500_000_000, nil},
+		{"1k", 1000, nil},
+		{"1 KiB", 1024, nil},
+		{"1.5kib", 1536, nil},
+		{" 3 MiB ", 3 << 20, nil},
+		{"-1 MB", -1_000_000, nil},
+		{"7 EiB", 7 << 60, nil},
+		{"0.5 B", 1, nil},
+		{"8 EiB", 0, ErrRange},
+		{"", 0, ErrSyntax},
+		{"MB", 0, ErrSyntax},
+		{"1 XB", 0, ErrSyntax},
+		{"1 K B", 0, ErrSyntax},
+		{"abc", 0, ErrSyntax},
+		{"NaN", 0, ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := ParseBytes(tt.s)
+			if got != tt.want || !errors.Is(err, tt.wantError) {
+				t.Errorf("ParseBytes(%q) = %d, %v, want %d, %v", tt.s, got, err, tt.want, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{250 * time.Millisecond, "250ms"},
+		{time.Second, "1s"},
+		{65 * time.Minute, "1h 5m"},
+		{time.Hour + 1500*time.Millisecond, "1h 2s"},
+		{51*time.Hour + 10*time.Second, "2d 3h 10s"},
+		{-90 * time.Second, "-1m 30s"},
+		{math.MaxInt64, "106751d 23h 47m 17s"},
+		{math.MinInt64, "-106751d 23h 47m 17s"},
+		{-time.Nanosecond, "-1ns"},
+	}
+	for _, tt := range tests {
+		if got := FormatDuration(tt.d); got != tt.want {
+			t.Errorf("FormatDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		s         string
+		want      time.Duration
+		wantError error
+	}{
+		{"0", 0, nil},
+		{"1h 5m", 65 * time.Minute, nil},
+		{"1h5m", 65 * time.Minute, nil},
+		{"1.5 hours", 90 * time.Minute, nil},
+		{"2 days 3 hours", 51 * time.Hour, nil},
+		{"2d 3h 10s", 51*time.Hour + 10*time.Second, nil},
+		{"1 week", 7 * 24 * time.Hour, nil},
+		{"1h, 30 mins and 15 seconds", time.Hour + 30*time.Minute + 15*time.Second, nil},
+		{"250ms", 250 * time.Millisecond, nil},
+		{"-1m 30s", -90 * time.Second, nil},
+		{"3 Days", 72 * time.Hour, nil},
+		{"1μs", time.Microsecond, nil},
+		{"1µs", time.Microsecond, nil},
+		{".5s", 500 * time.Millisecond, nil},
+		{"1.000000001s", time.Second + time.Nanosecond, nil},
+		{"2562047h 47m 16.854775807s", math.MaxInt64, nil},
+		{"9223372036854775807ns", math.MaxInt64, nil},
+		{"-9223372036854775808ns", math.MinInt64, nil},
+		{"", 0, ErrSyntax},
+		{"5", 0, ErrSyntax},
+		{"h", 0, ErrSyntax},
+		{"5 fortnights", 0, ErrSyntax},
+		{"1..5h", 0, ErrSyntax},
+		{"--5m", 0, ErrSyntax},
+		{"100000000 weeks", 0, ErrRange},
+		{"9223372036854775808ns", 0, ErrRange},
+		{"9223372036854775807ns 1ns", 0, ErrRange},
+		{"99999999999999999999h", 0, ErrRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := ParseDuration(tt.s)
+			if got != tt.want || !errors.Is(err, tt.wantError) {
+				t.Errorf("ParseDuration(%q) = %v, %v, want %v, %v", tt.s, got, err, tt.want, tt.wantError)
+			}
+		})
+	}
+	for _, d := range []time.Duration{time.Second, 65 * time.Minute, 51*time.Hour + 10*time.Second, -90 * time.Second} {
+		if got, err := ParseDuration(FormatDuration(d)); got != d || err != nil {
+			t.Errorf("ParseDuration(FormatDuration(%v)) = %v, %v", d, got, err)
+		}
+	}
+}
+
+func ExampleFormatRelative() {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	fmt.Println(FormatRelative(now.Add(-72*time.Hour), now))
+	fmt.Println(FormatRelative(now.Add(2*time.Hour), now))
+	//Output: 3 days ago
+	//in 2 hours
+}
+
+func TestFormatRelative(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "just now"},
+		{-59 * time.Second, "just now"},
+		{-time.Minute, "1 minute ago"},
+		{-47 * time.Hour, "1 day ago"},
+		{-48 * time.Hour, "2 days ago"},
+		{-14 * 24 * time.Hour, "2 weeks ago"},
+		{-60 * 24 * time.Hour, "2 months ago"},
+		{-400 * 24 * time.Hour, "1 year ago"},
+		{90 * time.Minute, "in 1 hour"},
+		{5 * time.Minute, "in 5 minutes"},
+	}
+	for _, tt := range tests {
+		if got := FormatRelative(now.Add(tt.d), now); got != tt.want {
+			t.Errorf("FormatRelative(now%+v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestParseRelative(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		s       string
+		want    time.Time
+		wantErr bool
+	}{
+		{"now", now, false},
+		{"Just now", now, false},
+		{"3 days ago", now.Add(-72 * time.Hour), false},
+		{"in 2 hours", now.Add(2 * time.Hour), false},
+		{"in 1h 30m", now.Add(90 * time.Minute), false},
+		{"1 month ago", now.Add(-30 * 24 * time.Hour), false},
+		{"in 2 years", now.Add(2 * 365 * 24 * time.Hour), false},
+		{"3 days", time.Time{}, true},
+		{"in forever", time.Time{}, true},
+		{"soon ago", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseRelative(tt.s, now)
+		if !got.Equal(tt.want) || (err != nil) != tt.wantErr {
+			t.Errorf("ParseRelative(%q) = %v, %v, want %v, wantErr %v", tt.s, got, err, tt.want, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrSyntax) {
+			t.Errorf("ParseRelative(%q) error %v is not ErrSyntax", tt.s, err)
+		}
+	}
+	for _, u := range relativeUnits {
+		for _, n := range []time.Duration{1, 3} {
+			for _, d := range []time.Duration{-n * u.d, n * u.d} {
+				s := FormatRelative(now.Add(d), now)
+				if got, err := ParseRelative(s, now); !got.Equal(now.Add(d)) || err != nil {
+					t.Errorf("ParseRelative(%q) = %v, %v, want %v", s, got, err, now.Add(d))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package strings
+
+import (
+	"go/token"
+	"go/types"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ToGoIdentifier converts s, which can be anything like a database column name, JSON key or label,
+// into a valid Go identifier. If the first letter of s is upper case, the result is exported like
+// ToExportedName, otherwise it is unexported like ToUnexportedName.
+func ToGoIdentifier(s string) string {
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			return goIdentifier(s, unicode.IsUpper(r) || unicode.IsTitle(r))
+		}
+	}
+	return goIdentifier(s, false)
+}
+
+// ToExportedName converts s into a valid exported Go identifier in PascalCase, like "UserID" from "user_id".
+//
+// Runes that cannot be in an identifier are treated as word separators and removed. Words in DefaultAcronyms are
+// put in upper case, the way golint expects. If the result would not start with an upper case letter,
+// for example because s starts with a number, it is prefixed with "X". An s with no letters or numbers gives "X".
+func ToExportedName(s string) string {
+	return goIdentifier(s, true)
+}
+
+// ToUnexportedName converts s into a valid unexported Go identifier in lowerCamelCase, like "userID" from "User ID".
+//
+// Runes that cannot be in an identifier are treated as word separators and removed. Words in DefaultAcronyms are
+// put in upper case, except for the first word. If the result would not start with a letter, it is prefixed
+// with "x". If the result is a Go keyword or predeclared identifier, like "type" or "string", an underscore is
+// added to the end. An s with no letters or numbers gives "x".
+func ToUnexportedName(s string) string {
+	return goIdentifier(s, false)
+}
+
+func goIdentifier(s string, exported bool) string {
+	style, prefix := LowerCamelCase, "x"
+	if exported {
+		style, prefix = PascalCase, "X"
+	}
+	// Composing first keeps letters with combining marks, since the marks themselves cannot be in an identifier.
+	id := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, ToCase(norm.NFC.String(s), style))
+
+	r, _ := utf8.DecodeRuneInString(id)
+	if !unicode.IsLetter(r) || (exported && !unicode.IsUpper(r)) {
+		id = prefix + id
+	}
+	if !exported && (token.IsKeyword(id) || types.Universe.Lookup(id) != nil) {
+		id += "_"
+	}
+	return id
+}
@@ -0,0 +1,108 @@
+package strings
+
+import (
+	"fmt"
+	"go/token"
+	"testing"
+)
+
+func ExampleToExportedName() {
+	fmt.Println(ToExportedName("user_id"))
+	fmt.Println(ToExportedName("2fa code"))
+	//Output: UserID
+	//X2faCode
+}
+
+func TestToExportedName(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"", "X"},
+		{"$%^", "X"},
+		{"user_id", "UserID"},
+		{"created-at", "CreatedAt"},
+		{"First Name", "FirstName"},
+		{"e-mail address?", "EMailAddress"},
+		{"2fa code", "X2faCode"},
+		{"type", "Type"},
+		{"élan", "Élan"},
+		{"naïve", "Naïve"},
+		{"日本語", "X日本語"},
+		{"price_€", "Price"},
+		{"http_url", "HTTPURL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got := ToExportedName(tt.s)
+			if got != tt.want {
+				t.Errorf("ToExportedName(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+			if !token.IsIdentifier(got) || !token.IsExported(got) {
+				t.Errorf("ToExportedName(%q) = %q is not an exported identifier", tt.s, got)
+			}
+		})
+	}
+}
+
+func TestToUnexportedName(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"", "x"},
+		{"---", "x"},
+		{"UserID", "userID"},
+		{"ID", "id"},
+		{"URL path", "urlPath"},
+		{"type", "type_"},
+		{"Type", "type_"},
+		{"func", "func_"},
+		{"string", "string_"},
+		{"len", "len_"},
+		{"nil", "nil_"},
+		{"iota", "iota_"},
+		{"any", "any_"},
+		{"types", "types"},
+		{"2fa code", "x2faCode"},
+		{"日本語", "日本語"},
+		{"Élan Vital", "élanVital"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got := ToUnexportedName(tt.s)
+			if got != tt.want {
+				t.Errorf("ToUnexportedName(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+			if !token.IsIdentifier(got) || token.IsExported(got) {
+				t.Errorf("ToUnexportedName(%q) = %q is not an unexported identifier", tt.s, got)
+			}
+		})
+	}
+}
+
+func TestToGoIdentifier(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"", "x"},
+		{"user_id", "userID"},
+		{"User_id", "UserID"},
+		{"_User", "User"},
+		{"123 main", "x123Main"},
+		{"123 Main", "X123Main"},
+		{"range", "range_"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got := ToGoIdentifier(tt.s)
+			if got != tt.want {
+				t.Errorf("ToGoIdentifier(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+			if !token.IsIdentifier(got) {
+				t.Errorf("ToGoIdentifier(%q) = %q is not an identifier", tt.s, got)
+			}
+		})
+	}
+}
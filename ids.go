@@ -0,0 +1,379 @@
+package strings
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// UUID is a universally unique identifier, as described in RFC 9562.
+type UUID [16]byte
+
+// NewUUIDv4 returns a version 4 UUID, which is made of 122 random bits from crypto/rand.
+// The error is from crypto/rand, and should never happen.
+func NewUUIDv4() (UUID, error) {
+	return cryptoGenerator.UUIDv4()
+}
+
+// NewUUIDv7 returns a version 7 UUID, which starts with the current time in milliseconds and is followed by
+// 74 random bits from crypto/rand. UUIDs made in later milliseconds sort after earlier ones.
+// The error is from crypto/rand, and should never happen.
+func NewUUIDv7() (UUID, error) {
+	return cryptoGenerator.UUIDv7(time.Now())
+}
+
+// UUIDv4 returns a version 4 UUID using the randomness of g.
+func (g *Generator) UUIDv4() (UUID, error) {
+	var u UUID
+	if _, err := g.Read(u[:]); err != nil {
+		return UUID{}, err
+	}
+	u.setVersion(4)
+	return u, nil
+}
+
+// UUIDv7 returns a version 7 UUID for the time t using the randomness of g.
+// If t is before 1970 or after the year 10889, the error wraps ErrRange.
+func (g *Generator) UUIDv7(t time.Time) (UUID, error) {
+	var u UUID
+	ms := t.UnixMilli()
+	if ms < 0 || ms >= 1<<48 {
+		return UUID{}, fmt.Errorf("UUID time %v: %w", t, ErrRange)
+	}
+	if _, err := g.Read(u[6:]); err != nil {
+		return UUID{}, err
+	}
+	putUint48(u[:6], uint64(ms))
+	u.setVersion(7)
+	return u, nil
+}
+
+func (u *UUID) setVersion(v byte) {
+	u[6] = u[6]&0x0f | v<<4
+	u[8] = u[8]&0x3f | 0x80 // the RFC 9562 variant
+}
+
+// ParseUUID parses a UUID in the standard form of 32 hex digits in groups of 8, 4, 4, 4 and 12 separated by
+// hyphens, like "f81d4fae-7dec-11d0-a765-00a0c91e6bf6". Upper case hex digits are accepted.
+// It accepts UUIDs of any version.
+//
+// If s is not a UUID, the error wraps ErrSyntax.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return UUID{}, fmt.Errorf("parsing UUID %q: %w", s, ErrSyntax)
+	}
+	digits := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	if _, err := hex.Decode(u[:], []byte(digits)); err != nil {
+		return UUID{}, fmt.Errorf("parsing UUID %q: %w", s, ErrSyntax)
+	}
+	return u, nil
+}
+
+// IsUUID returns true if s is a UUID that ParseUUID accepts.
+func IsUUID(s string) bool {
+	_, err := ParseUUID(s)
+	return err == nil
+}
+
+// String returns u in the standard form, with lower case hex digits.
+func (u UUID) String() string {
+	var b [36]byte
+	hex.Encode(b[0:8], u[0:4])
+	hex.Encode(b[9:13], u[4:6])
+	hex.Encode(b[14:18], u[6:8])
+	hex.Encode(b[19:23], u[8:10])
+	hex.Encode(b[24:], u[10:])
+	b[8], b[13], b[18], b[23] = '-', '-', '-', '-'
+	return string(b[:])
+}
+
+// Version returns the version of u, like 4 or 7.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// Time returns the time a version 7 UUID was made, to the millisecond. ok is false for other versions.
+func (u UUID) Time() (t time.Time, ok bool) {
+	if u.Version() != 7 {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(int64(uint48(u[:6]))), true
+}
+
+// ULID is a universally unique lexicographically sortable identifier. It is a 48-bit time in milliseconds
+// followed by 80 random bits, written as 26 characters of Crockford's base32, like "01ARYZ6S41TSV4RRFFQ69G5FAV".
+// See https://github.com/ulid/spec.
+type ULID [16]byte
+
+// crockford is Crockford's base32 alphabet, which leaves out I, L, O and U.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// NewULID returns a ULID for the current time, with random bits from crypto/rand.
+// The error is from crypto/rand, and should never happen.
+func NewULID() (ULID, error) {
+	return cryptoGenerator.ULID(time.Now())
+}
+
+// ULID returns a ULID for the time t using the randomness of g.
+// If t is before 1970 or after the year 10889, the error wraps ErrRange.
+func (g *Generator) ULID(t time.Time) (ULID, error) {
+	var u ULID
+	ms := t.UnixMilli()
+	if ms < 0 || ms >= 1<<48 {
+		return ULID{}, fmt.Errorf("ULID time %v: %w", t, ErrRange)
+	}
+	if _, err := g.Read(u[6:]); err != nil {
+		return ULID{}, err
+	}
+	putUint48(u[:6], uint64(ms))
+	return u, nil
+}
+
+// MonotonicULID makes ULIDs that always sort in the order they were made, even within a millisecond.
+// A ULID made in the same millisecond as the one before it, or in an earlier one because the clock went back,
+// is the one before it plus one. It is safe for concurrent use.
+type MonotonicULID struct {
+	g    *Generator
+	mu   sync.Mutex
+	last ULID
+}
+
+// NewMonotonicULID returns a MonotonicULID that gets its randomness from g.
+// A nil g uses crypto/rand.
+func NewMonotonicULID(g *Generator) *MonotonicULID {
+	if g == nil {
+		g = cryptoGenerator
+	}
+	return &MonotonicULID{g: g}
+}
+
+// New returns the next ULID for the time t.
+// If the random bits run out within a millisecond, which takes 2^80 ULIDs in the worst case,
+// or t is out of range, the error wraps ErrRange.
+func (m *MonotonicULID) New(t time.Time) (ULID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if ms := t.UnixMilli(); ms >= 0 && uint64(ms) <= uint48(m.last[:6]) && m.last != (ULID{}) {
+		u := m.last
+		for i := len(u) - 1; i >= 6; i-- {
+			u[i]++
+			if u[i] != 0 {
+				m.last = u
+				return u, nil
+			}
+		}
+		return ULID{}, fmt.Errorf("ULID random bits used up at %v: %w", t, ErrRange)
+	}
+	u, err := m.g.ULID(t)
+	if err != nil {
+		return ULID{}, err
+	}
+	m.last = u
+	return u, nil
+}
+
+// ParseULID parses a ULID of 26 characters of Crockford's base32. Lower case letters are accepted.
+//
+// If s is not a ULID, the error wraps ErrSyntax.
+func ParseULID(s string) (ULID, error) {
+	var u ULID
+	if len(s) != 26 || s[0] > '7' {
+		return ULID{}, fmt.Errorf("parsing ULID %q: %w", s, ErrSyntax)
+	}
+	// The 26 characters hold 130 bits, and the first 2 are always zero.
+	for i := 0; i < len(s); i++ {
+		v := strings.IndexByte(crockford, upperASCII(s[i]))
+		if v < 0 {
+			return ULID{}, fmt.Errorf("parsing ULID %q: %w", s, ErrSyntax)
+		}
+		for k := 0; k < 5; k++ {
+			if p := i*5 - 2 + k; p >= 0 && v&(1<<(4-k)) != 0 {
+				u[p/8] |= 1 << (7 - p%8)
+			}
+		}
+	}
+	return u, nil
+}
+
+// IsULID returns true if s is a ULID that ParseULID accepts.
+func IsULID(s string) bool {
+	_, err := ParseULID(s)
+	return err == nil
+}
+
+// String returns u as 26 characters of Crockford's base32.
+func (u ULID) String() string {
+	var b [26]byte
+	for i := range b {
+		var v byte
+		for k := 0; k < 5; k++ {
+			v <<= 1
+			if p := i*5 - 2 + k; p >= 0 {
+				v |= u[p/8] >> (7 - p%8) & 1
+			}
+		}
+		b[i] = crockford[v]
+	}
+	return string(b[:])
+}
+
+// Time returns the time u was made, to the millisecond.
+func (u ULID) Time() time.Time {
+	return time.UnixMilli(int64(uint48(u[:6])))
+}
+
+// NanoIDAlphabet is the alphabet of NanoIDs. Its 64 characters are safe in URLs.
+const NanoIDAlphabet = AlphaNumeric + "_-"
+
+// NanoIDLength is the length of NanoIDs, which gives 126 random bits.
+const NanoIDLength = 21
+
+var nanoIDAlphabet = MustAlphabet(NanoIDAlphabet)
+
+// NewNanoID returns a NanoID, which is NanoIDLength random characters from NanoIDAlphabet, using crypto/rand.
+// The error is from crypto/rand, and should never happen.
+func NewNanoID() (string, error) {
+	return nanoIDAlphabet.CryptoString(NanoIDLength)
+}
+
+// NewNanoIDWith returns a NanoID of n characters from a custom alphabet, using crypto/rand.
+// The alphabet can have any Unicode characters. See NewAlphabet.
+func NewNanoIDWith(alphabet string, n int) (string, error) {
+	a, err := NewAlphabet(alphabet)
+	if err != nil {
+		return "", err
+	}
+	return a.CryptoString(n)
+}
+
+// IsNanoID returns true if s is NanoIDLength characters from NanoIDAlphabet.
+func IsNanoID(s string) bool {
+	return IsNanoIDWith(s, NanoIDAlphabet, NanoIDLength)
+}
+
+// IsNanoIDWith returns true if s is n characters from alphabet.
+func IsNanoIDWith(s string, alphabet string, n int) bool {
+	if !utf8.ValidString(s) {
+		return false
+	}
+	var count int
+	for _, r := range s {
+		if !strings.ContainsRune(alphabet, r) {
+			return false
+		}
+		count++
+	}
+	return count == n
+}
+
+// KSUID is a K-sortable unique identifier. It is a 32-bit time in seconds since the KSUID epoch
+// of 2014-05-13 16:53:20 UTC followed by 128 random bits, written as 27 base62 characters,
+// like "0ujtsYcgvSTl8PAuAdqWYSMnLOv". See https://github.com/segmentio/ksuid.
+type KSUID [20]byte
+
+// ksuidEpoch is the time of a zero KSUID timestamp, in Unix seconds.
+const ksuidEpoch = 1400000000
+
+// base62 is the alphabet of KSUIDs, in ASCII order so that the strings sort like the bytes.
+const base62 = Numbers + AlphaUpper + AlphaLower
+
+// NewKSUID returns a KSUID for the current time, with random bits from crypto/rand.
+// The error is from crypto/rand, and should never happen.
+func NewKSUID() (KSUID, error) {
+	return cryptoGenerator.KSUID(time.Now())
+}
+
+// KSUID returns a KSUID for the time t using the randomness of g.
+// If t is before the KSUID epoch or more than 2^32 seconds after it, the error wraps ErrRange.
+func (g *Generator) KSUID(t time.Time) (KSUID, error) {
+	var k KSUID
+	sec := t.Unix() - ksuidEpoch
+	if sec < 0 || sec > math.MaxUint32 {
+		return KSUID{}, fmt.Errorf("KSUID time %v: %w", t, ErrRange)
+	}
+	if _, err := g.Read(k[4:]); err != nil {
+		return KSUID{}, err
+	}
+	binary.BigEndian.PutUint32(k[:4], uint32(sec))
+	return k, nil
+}
+
+// ParseKSUID parses a KSUID of 27 base62 characters.
+//
+// If s is not a KSUID, the error wraps ErrSyntax.
+func ParseKSUID(s string) (KSUID, error) {
+	var k KSUID
+	if len(s) != 27 {
+		return KSUID{}, fmt.Errorf("parsing KSUID %q: %w", s, ErrSyntax)
+	}
+	for i := 0; i < len(s); i++ {
+		carry := strings.IndexByte(base62, s[i])
+		if carry < 0 {
+			return KSUID{}, fmt.Errorf("parsing KSUID %q: %w", s, ErrSyntax)
+		}
+		for j := len(k) - 1; j >= 0; j-- {
+			carry += int(k[j]) * 62
+			k[j] = byte(carry)
+			carry >>= 8
+		}
+		if carry != 0 {
+			return KSUID{}, fmt.Errorf("parsing KSUID %q: %w", s, ErrSyntax)
+		}
+	}
+	return k, nil
+}
+
+// IsKSUID returns true if s is a KSUID that ParseKSUID accepts.
+func IsKSUID(s string) bool {
+	_, err := ParseKSUID(s)
+	return err == nil
+}
+
+// String returns k as 27 base62 characters.
+func (k KSUID) String() string {
+	var b [27]byte
+	num := k
+	for i := len(b) - 1; i >= 0; i-- {
+		var rem int
+		for j := range num {
+			acc := rem<<8 | int(num[j])
+			num[j] = byte(acc / 62)
+			rem = acc % 62
+		}
+		b[i] = base62[rem]
+	}
+	return string(b[:])
+}
+
+// Time returns the time k was made, to the second.
+func (k KSUID) Time() time.Time {
+	return time.Unix(int64(binary.BigEndian.Uint32(k[:4]))+ksuidEpoch, 0)
+}
+
+func putUint48(b []byte, v uint64) {
+	for i := 5; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+}
+
+func uint48(b []byte) uint64 {
+	var v uint64
+	for _, c := range b[:6] {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+func upperASCII(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
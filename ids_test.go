@@ -0,0 +1,184 @@
+package strings
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func ExampleParseULID() {
+	u, _ := ParseULID("01ARYZ6S41TSV4RRFFQ69G5FAV")
+	fmt.Println(u.Time().UTC().Format(time.RFC3339Nano))
+	//Output: 2016-07-30T22:36:16.385Z
+}
+
+func TestUUID(t *testing.T) {
+	u, err := NewUUIDv4()
+	if err != nil || u.Version() != 4 || u[8]&0xc0 != 0x80 {
+		t.Errorf("NewUUIDv4() = %v, %v", u, err)
+	}
+	if _, ok := u.Time(); ok {
+		t.Error("a version 4 UUID has a time")
+	}
+	if p, err := ParseUUID(u.String()); err != nil || p != u {
+		t.Errorf("ParseUUID(%q) = %v, %v", u.String(), p, err)
+	}
+
+	now := time.Now()
+	u, err = NewUUIDv7()
+	if tm, ok := u.Time(); err != nil || u.Version() != 7 || !ok || tm.Sub(now).Abs() > time.Second {
+		t.Errorf("NewUUIDv7() = %v, %v, time %v", u, err, tm)
+	}
+
+	// the example from RFC 9562, appendix A.6
+	u, err = ParseUUID("017F22E2-79B0-7CC3-98C4-DC0C0C07398F")
+	tm, _ := u.Time()
+	if err != nil || !tm.Equal(time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)) {
+		t.Errorf("ParseUUID() time = %v, %v", tm, err)
+	}
+	if u.String() != "017f22e2-79b0-7cc3-98c4-dc0c0c07398f" {
+		t.Errorf("String() = %q", u.String())
+	}
+
+	g := NewSeededGenerator(5)
+	var ids []string
+	for i := 0; i < 10; i++ {
+		u, _ := g.UUIDv7(time.UnixMilli(int64(1_700_000_000_000 + i)))
+		ids = append(ids, u.String())
+	}
+	if !sort.StringsAreSorted(ids) {
+		t.Errorf("UUIDv7s are not in time order: %q", ids)
+	}
+	if _, err := g.UUIDv7(time.UnixMilli(-1)); !errors.Is(err, ErrRange) {
+		t.Errorf("UUIDv7() before 1970 error = %v", err)
+	}
+
+	for _, s := range []string{
+		"", "f81d4fae7dec11d0a76500a0c91e6bf6", "f81d4fae-7dec-11d0-a765-00a0c91e6bf", "{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}",
+		"f81d4fae-7dec-11d0-a765_00a0c91e6bf6", "g81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+	} {
+		if IsUUID(s) {
+			t.Errorf("IsUUID(%q) = true", s)
+		}
+	}
+	if !IsUUID("f81d4fae-7dec-11d0-a765-00a0c91e6bf6") {
+		t.Error("IsUUID() = false")
+	}
+}
+
+func TestULID(t *testing.T) {
+	u, err := ParseULID("01ARYZ6S41TSV4RRFFQ69G5FAV")
+	if err != nil || u.Time().UnixMilli() != 1469918176385 || u.String() != "01ARYZ6S41TSV4RRFFQ69G5FAV" {
+		t.Errorf("ParseULID() = %v, %v", u, err)
+	}
+	if l, err := ParseULID("01aryz6s41tsv4rrffq69g5fav"); err != nil || l != u {
+		t.Errorf("ParseULID() of lower case = %v, %v", l, err)
+	}
+	if m, err := ParseULID("7ZZZZZZZZZZZZZZZZZZZZZZZZZ"); err != nil || m.String() != "7ZZZZZZZZZZZZZZZZZZZZZZZZZ" {
+		t.Errorf("ParseULID() of the largest ULID = %v, %v", m, err)
+	}
+	for _, s := range []string{"", "80000000000000000000000000", "01ARYZ6S41TSV4RRFFQ69G5FA", "01ARYZ6S41TSV4RRFFQ69G5FAU", "01ARYZ6S41TSV4RRFFQ69G5FAVV"} {
+		if IsULID(s) {
+			t.Errorf("IsULID(%q) = true", s)
+		}
+	}
+
+	now := time.Now()
+	u, err = NewULID()
+	if err != nil || u.Time().Sub(now).Abs() > time.Second || !IsULID(u.String()) {
+		t.Errorf("NewULID() = %v, %v", u, err)
+	}
+}
+
+func TestMonotonicULID(t *testing.T) {
+	m := NewMonotonicULID(NewSeededGenerator(1))
+	tm := time.UnixMilli(1_700_000_000_000)
+	var ids []string
+	for i := 0; i < 100; i++ {
+		// the clock goes back at i == 50
+		at := tm.Add(time.Duration(i%50/10) * time.Millisecond)
+		u, err := m.New(at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, u.String())
+	}
+	for i := 1; i < len(ids); i++ {
+		if ids[i] <= ids[i-1] {
+			t.Fatalf("ULID %d %q is not after %q", i, ids[i], ids[i-1])
+		}
+	}
+
+	m = NewMonotonicULID(NewGenerator(constReader(0xff)))
+	if _, err := m.New(tm); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.New(tm); !errors.Is(err, ErrRange) {
+		t.Errorf("New() after the largest ULID error = %v", err)
+	}
+}
+
+// constReader is a reader that always returns the same byte.
+type constReader byte
+
+func (r constReader) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = byte(r)
+	}
+	return len(b), nil
+}
+
+func TestNanoID(t *testing.T) {
+	id, err := NewNanoID()
+	if err != nil || !IsNanoID(id) || len(id) != NanoIDLength {
+		t.Errorf("NewNanoID() = %q, %v", id, err)
+	}
+	id, err = NewNanoIDWith("あいうえお", 10)
+	if err != nil || utf8.RuneCountInString(id) != 10 || !IsNanoIDWith(id, "あいうえお", 10) {
+		t.Errorf("NewNanoIDWith() = %q, %v", id, err)
+	}
+	if _, err := NewNanoIDWith("x", 10); !errors.Is(err, ErrSyntax) {
+		t.Errorf("NewNanoIDWith() with one symbol error = %v", err)
+	}
+	for _, s := range []string{"", "V1StGXR8_Z5jdHi6B-myT!", "V1StGXR8_Z5jdHi6B-my", "V1StGXR8_Z5jdHi6B-myTT"} {
+		if IsNanoID(s) {
+			t.Errorf("IsNanoID(%q) = true", s)
+		}
+	}
+	if !IsNanoID("V1StGXR8_Z5jdHi6B-myT") {
+		t.Error("IsNanoID() = false")
+	}
+}
+
+func TestKSUID(t *testing.T) {
+	k, err := ParseKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	if err != nil || !k.Time().Equal(time.Date(2017, 10, 10, 4, 0, 47, 0, time.UTC)) {
+		t.Errorf("ParseKSUID() = %v, %v, time %v", k, err, k.Time())
+	}
+	if fmt.Sprintf("%X", k[4:]) != "B5A1CD34B5F99D1154FB6853345C9735" || k.String() != "0ujtsYcgvSTl8PAuAdqWYSMnLOv" {
+		t.Errorf("ParseKSUID() = %X, %q", k, k.String())
+	}
+	if m, err := ParseKSUID("aWgEPTl1tmebfsQzFP4bxwgy80V"); err != nil || m != (KSUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}) {
+		t.Errorf("ParseKSUID() of the largest KSUID = %X, %v", m, err)
+	}
+	if (KSUID{}).String() != "000000000000000000000000000" {
+		t.Errorf("String() of the zero KSUID = %q", KSUID{}.String())
+	}
+	for _, s := range []string{"", "aWgEPTl1tmebfsQzFP4bxwgy80W", "0ujtsYcgvSTl8PAuAdqWYSMnLO", "0ujtsYcgvSTl8PAuAdqWYSMnLO-"} {
+		if IsKSUID(s) {
+			t.Errorf("IsKSUID(%q) = true", s)
+		}
+	}
+
+	now := time.Now()
+	k, err = NewKSUID()
+	if err != nil || k.Time().Sub(now).Abs() > 2*time.Second || !IsKSUID(k.String()) {
+		t.Errorf("NewKSUID() = %v, %v", k, err)
+	}
+	if _, err := NewSeededGenerator(1).KSUID(time.Unix(ksuidEpoch-1, 0)); !errors.Is(err, ErrRange) {
+		t.Errorf("KSUID() before the epoch error = %v", err)
+	}
+}
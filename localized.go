@@ -0,0 +1,200 @@
+package strings
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// NumberOptions control how FormatNumber writes a number.
+type NumberOptions struct {
+	// MinFractionDigits is the least number of digits written after the decimal mark. Zeros are added to fill them.
+	MinFractionDigits int
+	// MaxFractionDigits is the most number of digits written after the decimal mark. The rest are rounded.
+	// Zero uses the default of the language, which is usually 3. A negative number writes no fraction digits.
+	MaxFractionDigits int
+	// NoGrouping turns off the separators between groups of digits, like the commas in "1,234,567".
+	NoGrouping bool
+}
+
+// FormatNumber writes v the way it is written in the language of tag, using the grouping separators,
+// decimal mark and digits of that language.
+//
+//	FormatNumber(1234567.891, language.German, NumberOptions{}) // 1.234.567,891
+//	FormatNumber(1234567, language.MustParse("en-IN"), NumberOptions{}) // 12,34,567
+func FormatNumber[T constraints.Integer | constraints.Float](v T, tag language.Tag, opts NumberOptions) string {
+	var o []number.Option
+	if opts.MinFractionDigits > 0 {
+		o = append(o, number.MinFractionDigits(opts.MinFractionDigits))
+	}
+	if opts.MaxFractionDigits > 0 {
+		o = append(o, number.MaxFractionDigits(opts.MaxFractionDigits))
+	} else if opts.MaxFractionDigits < 0 {
+		o = append(o, number.MaxFractionDigits(0))
+	}
+	if opts.NoGrouping {
+		o = append(o, number.NoSeparator())
+	}
+	return message.NewPrinter(tag).Sprint(number.Decimal(v, o...))
+}
+
+// ParseLocalized parses s as a number written the way it is written in the language of tag.
+// See NormalizeNumber for what is accepted.
+//
+// If s is not a number, the error wraps ErrSyntax. If s is too large for a float64,
+// the error wraps ErrRange.
+func ParseLocalized(s string, tag language.Tag) (float64, error) {
+	n, err := NormalizeNumber(s, tag)
+	if err != nil {
+		return 0, err
+	}
+	return ParseFloat[float64](n)
+}
+
+// NormalizeNumber converts a number written the way it is written in the language of tag into the plain form
+// that the other parsing functions in this package, like ParseInt and ParseDecimal, accept.
+// For example, "1.234.567,89" in German and "12,34,567.89" in Indian English both become "1234567.89".
+//
+// The decimal mark and grouping separator of the language are used. Where the grouping separator is
+// a space, any kind of space is accepted. Groups of digits must have 2 or 3 digits, except the first,
+// which can have 1 to 3 digits, and the last, which must have 3. The digits can be from any script,
+// like Arabic-Indic or Devanagari. The number can start with a plus or minus sign, and may be surrounded
+// by spaces and the invisible marks that control the direction of text.
+//
+// If s is not a number, the error wraps ErrSyntax.
+func NormalizeNumber(s string, tag language.Tag) (string, error) {
+	sym := symbolsFor(tag)
+	var b strings.Builder
+	var groupLen int    // digits in the current group
+	var grouped bool    // a group separator has been seen
+	var sawDigit bool   // a digit has been seen
+	var inFraction bool // the decimal mark has been seen
+	var afterGroup bool // the last rune was a group separator
+	syntaxErr := fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+
+	t := strings.TrimFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || isBidiMark(r)
+	})
+	t = strings.Map(func(r rune) rune {
+		if isBidiMark(r) {
+			return -1
+		}
+		return r
+	}, t)
+
+	for i, r := range t {
+		switch {
+		case i == 0 && (r == '-' || r == '\u2212' || r == sym.minus):
+			b.WriteByte('-')
+		case i == 0 && r == '+':
+		case unicode.IsDigit(r):
+			b.WriteByte(byte('0' + digitValue(r)))
+			sawDigit = true
+			afterGroup = false
+			groupLen++
+		case r == sym.decimal:
+			if inFraction || afterGroup || grouped && groupLen != 3 {
+				return "", syntaxErr
+			}
+			inFraction = true
+			b.WriteByte('.')
+		case !inFraction && sym.isGroup(r):
+			if !sawDigit || afterGroup || groupLen > 3 || grouped && groupLen < 2 {
+				return "", syntaxErr
+			}
+			grouped = true
+			afterGroup = true
+			groupLen = 0
+		default:
+			return "", syntaxErr
+		}
+	}
+	if !sawDigit || afterGroup || grouped && !inFraction && groupLen != 3 {
+		return "", syntaxErr
+	}
+	return b.String(), nil
+}
+
+// numberSymbols are the symbols a language uses to write numbers.
+type numberSymbols struct {
+	decimal rune
+	group   rune
+	minus   rune
+}
+
+var numberSymbolCache sync.Map
+
+// symbolsFor finds the symbols of a language by formatting numbers with it and looking at the result.
+func symbolsFor(tag language.Tag) numberSymbols {
+	if sym, ok := numberSymbolCache.Load(tag); ok {
+		return sym.(numberSymbols)
+	}
+	p := message.NewPrinter(tag)
+	sym := numberSymbols{decimal: '.', minus: '-'}
+	var seps []rune
+	for _, r := range p.Sprint(number.Decimal(1234567.5, number.MinFractionDigits(1))) {
+		if !unicode.IsDigit(r) && !isBidiMark(r) {
+			seps = append(seps, r)
+		}
+	}
+	if len(seps) > 0 {
+		sym.decimal = seps[len(seps)-1]
+	}
+	if len(seps) > 1 {
+		sym.group = seps[0]
+	}
+	for _, r := range p.Sprint(number.Decimal(-1)) {
+		if !unicode.IsDigit(r) && !isBidiMark(r) {
+			sym.minus = r
+			break
+		}
+	}
+	numberSymbolCache.Store(tag, sym)
+	return sym
+}
+
+// isGroup returns true if r is a grouping separator. Any space is accepted when the language groups with a space,
+// and an apostrophe is accepted when it groups with a right single quotation mark, since those are hard to type.
+func (sym numberSymbols) isGroup(r rune) bool {
+	switch {
+	case sym.group == 0:
+		return false
+	case r == sym.group:
+		return true
+	case isSpaceSeparator(sym.group):
+		return isSpaceSeparator(r)
+	case sym.group == '\u2019':
+		return r == '\''
+	}
+	return false
+}
+
+func isSpaceSeparator(r rune) bool {
+	return r == ' ' || r == '\u00a0' || r == '\u202f' || r == '\u2009'
+}
+
+// isBidiMark returns true if r is one of the invisible marks used to control the direction of text.
+func isBidiMark(r rune) bool {
+	return r == '\u200e' || r == '\u200f' || r == '\u061c'
+}
+
+// digitValue returns the value of the decimal digit r, which can be from any script.
+// Unicode encodes the decimal digits of each script in order as a run of ten runes starting with zero.
+func digitValue(r rune) int {
+	for _, rg := range unicode.Nd.R16 {
+		if r >= rune(rg.Lo) && r <= rune(rg.Hi) {
+			return int(r-rune(rg.Lo)) % 10
+		}
+	}
+	for _, rg := range unicode.Nd.R32 {
+		if r >= rune(rg.Lo) && r <= rune(rg.Hi) {
+			return int(r-rune(rg.Lo)) % 10
+		}
+	}
+	return -1
+}
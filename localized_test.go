@@ -0,0 +1,132 @@
+package strings
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/text/language"
+	"testing"
+)
+
+func ExampleParseLocalized() {
+	v, _ := ParseLocalized("1.234.567,89", language.German)
+	fmt.Println(v)
+	fmt.Println(FormatNumber(v, language.MustParse("en-IN"), NumberOptions{MaxFractionDigits: 2}))
+	//Output: 1.23456789e+06
+	//12,34,567.89
+}
+
+func TestNormalizeNumber(t *testing.T) {
+	enIN := language.MustParse("en-IN")
+	deCH := language.MustParse("de-CH")
+	tests := []struct {
+		s       string
+		tag     language.Tag
+		want    string
+		wantErr bool
+	}{
+		{"1,234,567.89", language.English, "1234567.89", false},
+		{"1,000", language.English, "1000", false},
+		{"-1,000", language.English, "-1000", false},
+		{"+1,000.5", language.English, "1000.5", false},
+		{" 1000 ", language.English, "1000", false},
+		{".5", language.English, ".5", false},
+		{"1.234.567,89", language.German, "1234567.89", false},
+		{"1,5", language.German, "1.5", false},
+		{"1 234 567,89", language.French, "1234567.89", false},
+		{"1\u00a0234\u00a0567,89", language.French, "1234567.89", false},
+		{"1\u202f234,5", language.French, "1234.5", false},
+		{"1 234 567,89", language.Russian, "1234567.89", false},
+		{"12,34,567", enIN, "1234567", false},
+		{"12,34,567.5", enIN, "1234567.5", false},
+		{"1’234’567.5", deCH, "1234567.5", false},
+		{"1'234'567.5", deCH, "1234567.5", false},
+		{"١٬٢٣٤٬٥٦٧٫٨٩", language.Arabic, "1234567.89", false},
+		{"\u061c-٠٫٥", language.Arabic, "-0.5", false},
+		{"۱۲۳٫۵", language.Persian, "123.5", false},
+		{"\u200e\u2212۰٫۵", language.Persian, "-0.5", false},
+		{"१२,३४,५६७.८९", language.Hindi, "1234567.89", false},
+		{"१२३", language.English, "123", false},
+		{"", language.English, "", true},
+		{"-", language.English, "", true},
+		{"abc", language.English, "", true},
+		{"1,00", language.English, "", true},
+		{"1,0000", language.English, "", true},
+		{"1234,567", language.English, "", true},
+		{",100", language.English, "", true},
+		{"1,,000", language.English, "", true},
+		{"1,000,", language.English, "", true},
+		{"1,000.5.5", language.English, "", true},
+		{"1.000,5,5", language.German, "", true},
+		{"1,234.567,8", language.English, "", true},
+		{"1,5.5", language.English, "", true},
+		{"1.234", language.French, "", true},
+		{"1-000", language.English, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag.String()+" "+tt.s, func(t *testing.T) {
+			got, err := NormalizeNumber(tt.s, tt.tag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeNumber(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrSyntax) {
+				t.Errorf("NormalizeNumber(%q) error %v is not ErrSyntax", tt.s, err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeNumber(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLocalized(t *testing.T) {
+	if v, err := ParseLocalized("1,000", language.English); v != 1000 || err != nil {
+		t.Errorf("ParseLocalized(1,000, en) = %v, %v", v, err)
+	}
+	if v, err := ParseLocalized("1,000", language.German); v != 1 || err != nil {
+		t.Errorf("ParseLocalized(1,000, de) = %v, %v", v, err)
+	}
+	if _, err := ParseLocalized("1x", language.English); !errors.Is(err, ErrSyntax) {
+		t.Errorf("ParseLocalized(1x) error = %v", err)
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"en", FormatNumber(1234567.891, language.English, NumberOptions{}), "1,234,567.891"},
+		{"de", FormatNumber(1234567.891, language.German, NumberOptions{}), "1.234.567,891"},
+		{"fr", FormatNumber(1234567, language.French, NumberOptions{}), "1\u00a0234\u00a0567"},
+		{"en-IN", FormatNumber(1234567, language.MustParse("en-IN"), NumberOptions{}), "12,34,567"},
+		{"ar", FormatNumber(1234.5, language.Arabic, NumberOptions{}), "١٬٢٣٤٫٥"},
+		{"hi deva", FormatNumber(123, language.MustParse("hi-u-nu-deva"), NumberOptions{}), "१२३"},
+		{"min fraction", FormatNumber(12, language.English, NumberOptions{MinFractionDigits: 2}), "12.00"},
+		{"max fraction", FormatNumber(1.23456, language.English, NumberOptions{MaxFractionDigits: 2}), "1.23"},
+		{"no fraction", FormatNumber(1234.5678, language.English, NumberOptions{MaxFractionDigits: -1}), "1,235"},
+		{"no grouping", FormatNumber(int64(1234567), language.English, NumberOptions{NoGrouping: true}), "1234567"},
+		{"uint8", FormatNumber(uint8(255), language.German, NumberOptions{}), "255"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("FormatNumber() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatNumber_RoundTrip(t *testing.T) {
+	tags := []string{"en", "de", "fr", "ru", "en-IN", "hi-u-nu-deva", "ar", "fa", "de-CH", "es", "bn"}
+	for _, s := range tags {
+		tag := language.MustParse(s)
+		for _, v := range []float64{0, -0.5, 12.25, 1234567.125, -98765.5} {
+			f := FormatNumber(v, tag, NumberOptions{MaxFractionDigits: 3})
+			got, err := ParseLocalized(f, tag)
+			if err != nil || got != v {
+				t.Errorf("%s: ParseLocalized(%q) = %v, %v, want %v", s, f, got, err, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package strings
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// NamingStrategy turns the name of a Go struct field into the name used for it somewhere else,
+// like a JSON key or database column.
+type NamingStrategy interface {
+	Name(goField string) string
+}
+
+// NamingFunc adapts an ordinary function to a NamingStrategy.
+type NamingFunc func(goField string) string
+
+// Name returns f(goField).
+func (f NamingFunc) Name(goField string) string {
+	return f(goField)
+}
+
+// CaseNaming is a NamingStrategy that converts field names to a CaseStyle using ToCaseWith.
+type CaseNaming struct {
+	Style   CaseStyle
+	Options CaseOptions
+}
+
+// Name returns goField converted to the style of n.
+func (n CaseNaming) Name(goField string) string {
+	return ToCaseWith(goField, n.Style, n.Options)
+}
+
+// The built-in naming strategies.
+var (
+	SnakeNaming          NamingStrategy = CaseNaming{Style: SnakeCase}
+	KebabNaming          NamingStrategy = CaseNaming{Style: KebabCase}
+	LowerCamelNaming     NamingStrategy = CaseNaming{Style: LowerCamelCase}
+	ScreamingSnakeNaming NamingStrategy = CaseNaming{Style: ScreamingSnakeCase}
+)
+
+// DictionaryNaming is a NamingStrategy that looks up field names in Names, and uses Fallback
+// for the fields that are not there. A nil Fallback returns the field name unchanged.
+type DictionaryNaming struct {
+	Names    map[string]string
+	Fallback NamingStrategy
+}
+
+// Name returns the name of goField from the dictionary, or from the fallback strategy.
+func (n DictionaryNaming) Name(goField string) string {
+	if name, ok := n.Names[goField]; ok {
+		return name
+	}
+	if n.Fallback == nil {
+		return goField
+	}
+	return n.Fallback.Name(goField)
+}
+
+// TagKey pairs a struct tag key, like "json" or "db", with the strategy used to name fields for that key.
+type TagKey struct {
+	Key    string
+	Naming NamingStrategy
+}
+
+// StructTags returns a struct tag for each exported field of the struct type t, keyed by field name.
+// Each tag has a value for each of the keys, in the order given, named using the key's strategy.
+// Fields promoted from embedded structs are included, and the embedded structs themselves are not.
+// t can also be a pointer to a struct. If it is not a struct, nil is returned.
+//
+//	StructTags(reflect.TypeOf(User{}), TagKey{"json", LowerCamelNaming}, TagKey{"db", SnakeNaming})
+//	// map[UserID:`json:"userID" db:"user_id"` ...]
+func StructTags(t reflect.Type, keys ...TagKey) map[string]reflect.StructTag {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	tags := make(map[string]reflect.StructTag)
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || (f.Anonymous && indirect(f.Type).Kind() == reflect.Struct) {
+			continue
+		}
+		var parts []string
+		for _, k := range keys {
+			parts = append(parts, k.Key+":"+strconv.Quote(k.Naming.Name(f.Name)))
+		}
+		tags[f.Name] = reflect.StructTag(strings.Join(parts, " "))
+	}
+	return tags
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,83 @@
+package strings
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type namingBase struct {
+	ID        int
+	CreatedAt string
+}
+
+type namingUser struct {
+	namingBase
+	UserName   string
+	HTTPProxy  string
+	Address2   string
+	unexported int
+}
+
+func ExampleStructTags() {
+	type Order struct {
+		OrderID     int
+		HomePageURL string
+	}
+	tags := StructTags(reflect.TypeOf(Order{}), TagKey{"json", LowerCamelNaming}, TagKey{"db", SnakeNaming})
+	fmt.Println(tags["OrderID"])
+	fmt.Println(tags["HomePageURL"])
+	//Output: json:"orderID" db:"order_id"
+	//json:"homePageURL" db:"home_page_url"
+}
+
+func TestNamingStrategies(t *testing.T) {
+	tests := []struct {
+		name   string
+		naming NamingStrategy
+		field  string
+		want   string
+	}{
+		{"snake", SnakeNaming, "UserID", "user_id"},
+		{"kebab", KebabNaming, "HTTPProxy", "http-proxy"},
+		{"lower camel", LowerCamelNaming, "UserID", "userID"},
+		{"screaming", ScreamingSnakeNaming, "Address2", "ADDRESS2"},
+		{"split digits", CaseNaming{Style: SnakeCase, Options: CaseOptions{SplitDigits: true}}, "Address2", "address_2"},
+		{"func", NamingFunc(func(f string) string { return "x_" + f }), "Name", "x_Name"},
+		{"dictionary hit", DictionaryNaming{Names: map[string]string{"ID": "pk"}, Fallback: SnakeNaming}, "ID", "pk"},
+		{"dictionary miss", DictionaryNaming{Names: map[string]string{"ID": "pk"}, Fallback: SnakeNaming}, "UserID", "user_id"},
+		{"dictionary no fallback", DictionaryNaming{}, "UserID", "UserID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.naming.Name(tt.field); got != tt.want {
+				t.Errorf("Name(%q) = %q, want %q", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStructTags(t *testing.T) {
+	keys := []TagKey{
+		{"json", LowerCamelNaming},
+		{"db", DictionaryNaming{Names: map[string]string{"ID": "pk"}, Fallback: SnakeNaming}},
+		{"yaml", KebabNaming},
+	}
+	want := map[string]reflect.StructTag{
+		"ID":        `json:"id" db:"pk" yaml:"id"`,
+		"CreatedAt": `json:"createdAt" db:"created_at" yaml:"created-at"`,
+		"UserName":  `json:"userName" db:"user_name" yaml:"user-name"`,
+		"HTTPProxy": `json:"httpProxy" db:"http_proxy" yaml:"http-proxy"`,
+		"Address2":  `json:"address2" db:"address2" yaml:"address2"`,
+	}
+	got := StructTags(reflect.TypeOf(&namingUser{}), keys...)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("StructTags() = %v, want %v", got, want)
+	}
+	if v := got["CreatedAt"].Get("db"); v != "created_at" {
+		t.Errorf("StructTags() db tag of CreatedAt = %q", v)
+	}
+	if StructTags(reflect.TypeOf(1), keys...) != nil {
+		t.Error("StructTags() of a non-struct is not nil")
+	}
+}
@@ -1,63 +1,412 @@
 package strings
 
 import (
+	"errors"
+	"fmt"
 	"golang.org/x/exp/constraints"
+	"golang.org/x/text/language"
+	"math"
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
+	"unsafe"
 )
 
 // ExtractNumbers returns a string with the digits contained in the given string.
+// All the digits are joined together. Use FindNumbers to find each separate number in a string.
+// Only decimal digits are kept, so Roman numerals like "Ⅻ", superscripts and fractions like "½" are dropped.
 func ExtractNumbers(in string) string {
 	return strings.Map(func(r rune) rune {
-		if unicode.IsNumber(r) {
+		if unicode.IsDigit(r) {
 			return r
 		}
 		return -1
 	}, in)
 }
 
+// NumberMatch is a number found in a string by FindNumbers.
+type NumberMatch struct {
+	// Start is the byte offset of the start of the number in the string searched.
+	Start int
+	// End is the byte offset just past the end of the number, so the number is s[Start:End].
+	End int
+	// Text is the number as written, including any sign, grouping separators and percent sign.
+	Text string
+	// Number is the number in the plain form ParseFloat accepts, like "-1234.5" or "2e10".
+	// Without an exponent, it is also in the form ParseDecimal accepts.
+	Number string
+	// Value is the value of the number. For a percentage, it is the number written before the percent sign,
+	// so "15%" has a Value of 15. A number too big for a float64, like "1e999", has a Value of +Inf or -Inf.
+	Value float64
+	// Integer is true if the number is written without a fraction or an exponent.
+	Integer bool
+	// Percent is true if the number is followed by a percent sign.
+	Percent bool
+}
+
+// FindNumbersOptions control what FindNumbersWith looks for.
+type FindNumbersOptions struct {
+	// IntegersOnly leaves out numbers written with a fraction or exponent, rather than returning their parts.
+	IntegersOnly bool
+	// Language selects the decimal mark and grouping separator. The zero value uses "." and ",".
+	Language language.Tag
+}
+
+// FindNumbers returns each number written in s, in order.
+//
+// A number is a run of digits, from any script, that may have:
+//   - a plus or minus sign in front, as long as the sign does not follow a letter or digit, so "555-1234" is two numbers.
+//   - grouping separators between groups of 3 digits, like "1,234,567".
+//   - a fraction, like "3.25" or ".5".
+//   - an exponent, like "6.02e23".
+//   - a percent sign after it, like "15%".
+//
+// For example, "Call 555-1234 ext. 12" has three numbers: 555, 1234 and 12.
+func FindNumbers(s string) []NumberMatch {
+	return FindNumbersWith(s, FindNumbersOptions{})
+}
+
+// FindNumbersWith is like FindNumbers, but uses the given options.
+func FindNumbersWith(s string, opts FindNumbersOptions) []NumberMatch {
+	sym := symbolsFor(opts.Language)
+	var matches []NumberMatch
+	for i := 0; i < len(s); {
+		if m, ok := scanNumber(s, i, sym); ok {
+			if !opts.IntegersOnly || m.Integer {
+				matches = append(matches, m)
+			}
+			i = m.End
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+	return matches
+}
+
+// scanNumber returns the number that starts at byte offset start of s, if there is one.
+func scanNumber(s string, start int, sym numberSymbols) (m NumberMatch, ok bool) {
+	prev, _ := utf8.DecodeLastRuneInString(s[:start])
+	if start > 0 && unicode.IsDigit(prev) {
+		// only start at the beginning of a run of digits
+		return m, false
+	}
+	var b strings.Builder
+	j := start
+	if r, size := utf8.DecodeRuneInString(s[j:]); r == '+' || r == '-' || r == '\u2212' || r == sym.minus {
+		if start > 0 && (unicode.IsLetter(prev) || unicode.IsDigit(prev)) {
+			return m, false
+		}
+		if r != '+' {
+			b.WriteByte('-')
+		}
+		j += size
+	}
+
+	m.Integer = true
+	n := scanDigits(s, &j, &b)
+	if n > 0 && n <= 3 {
+		// grouping separators must be followed by exactly 3 digits
+		for {
+			r, size := utf8.DecodeRuneInString(s[j:])
+			if !(r == sym.group || isSpaceSeparator(sym.group) && r != ' ' && isSpaceSeparator(r)) {
+				break
+			}
+			k := j + size
+			if countDigits(s[k:], 4) != 3 {
+				break
+			}
+			j = k
+			scanDigits(s, &j, &b)
+		}
+	}
+	if r, size := utf8.DecodeRuneInString(s[j:]); r == sym.decimal && countDigits(s[j+size:], 1) == 1 {
+		b.WriteByte('.')
+		j += size
+		scanDigits(s, &j, &b)
+		m.Integer = false
+		n++
+	}
+	if n == 0 {
+		return m, false
+	}
+	if j < len(s) && (s[j] == 'e' || s[j] == 'E') {
+		k := j + 1
+		if k < len(s) && (s[k] == '+' || s[k] == '-') {
+			k++
+		}
+		if countDigits(s[k:], 1) == 1 {
+			b.WriteString(strings.ToLower(s[j:k]))
+			j = k
+			scanDigits(s, &j, &b)
+			m.Integer = false
+		}
+	}
+	if strings.HasPrefix(s[j:], "%") {
+		j++
+		m.Percent = true
+	} else if strings.HasPrefix(s[j:], " %") {
+		j += 2
+		m.Percent = true
+	}
+
+	m.Start, m.End = start, j
+	m.Text = s[start:j]
+	m.Number = b.String()
+	// Number is always well formed, so the only error is a number too big for a float64,
+	// which gives a Value of ±Inf. Dropping it would let its digits be found as another number.
+	m.Value, _ = strconv.ParseFloat(m.Number, 64)
+	return m, true
+}
+
+// scanDigits writes the digits that start at byte offset *j of s to b as ASCII digits, moves *j past them,
+// and returns how many there were.
+func scanDigits(s string, j *int, b *strings.Builder) int {
+	n := 0
+	for *j < len(s) {
+		r, size := utf8.DecodeRuneInString(s[*j:])
+		if !unicode.IsDigit(r) {
+			break
+		}
+		b.WriteByte(byte('0' + digitValue(r)))
+		*j += size
+		n++
+	}
+	return n
+}
+
+// countDigits returns the number of digits at the start of s, counting no more than limit.
+func countDigits(s string, limit int) int {
+	n := 0
+	for _, r := range s {
+		if n == limit || !unicode.IsDigit(r) {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// ErrSyntax is wrapped by the errors returned from the parsing functions when the input is not a number.
+// It is the same error as strconv.ErrSyntax.
+var ErrSyntax = strconv.ErrSyntax
+
+// ErrRange is wrapped by the errors returned from the parsing functions when the input is a number,
+// but it does not fit in the requested type. It is the same error as strconv.ErrRange.
+var ErrRange = strconv.ErrRange
+
+// RangeError is returned by ParseInt when the input does not fit in T. It carries the bounds of T,
+// and wraps ErrRange.
+//
+//	var re *RangeError[uint8]
+//	if _, err := ParseInt[uint8]("300"); errors.As(err, &re) {
+//		fmt.Printf("must be between %d and %d", re.Min, re.Max)
+//	}
+type RangeError[T constraints.Integer] struct {
+	// Input is the string that was parsed.
+	Input string
+	// Min is the smallest value T can hold.
+	Min T
+	// Max is the largest value T can hold.
+	Max T
+}
+
+func (e *RangeError[T]) Error() string {
+	return fmt.Sprintf("parsing %q: value out of range [%d, %d]", e.Input, e.Min, e.Max)
+}
+
+// Unwrap returns ErrRange.
+func (e *RangeError[T]) Unwrap() error {
+	return ErrRange
+}
+
+// ParseInt parses s as a base 10 integer of type T, which can be any integer type, including named types.
+// s may start with a + or -.
+//
+// If s is not an integer, the error wraps ErrSyntax. If s is an integer that does not fit in T,
+// including a negative number when T is unsigned, the error is a *RangeError[T], which wraps ErrRange.
+func ParseInt[T constraints.Integer](s string) (T, error) {
+	return ParseIntBase[T](s, 10)
+}
+
+// ParseIntBase is like ParseInt, but parses s in the given base, which must be from 2 to 36.
+// Letters, in either case, are used for digits above 9.
+//
+// If base is 0, s is parsed the way Go integer literals are written. The base is taken from the
+// prefix of s after any sign: "0x" for 16, "0o" or "0" for 8, "0b" for 2, and 10 otherwise. Underscores may be used
+// to separate digits, like "0b_1010_0101" or "1_000_000".
+//
+//	ParseIntBase[uint32]("0xFF_FF", 0) // 65535
+//	ParseIntBase[os.FileMode]("0755", 0) // 493
+func ParseIntBase[T constraints.Integer](s string, base int) (T, error) {
+	if base != 0 && (base < 2 || base > 36) {
+		return 0, fmt.Errorf("parsing %q: invalid base %d", s, base)
+	}
+	minT, maxT, bits, signed := intInfo[T]()
+	var v T
+	var err error
+	if signed {
+		var i int64
+		i, err = strconv.ParseInt(s, base, bits)
+		v = T(i)
+	} else {
+		var u uint64
+		u, err = strconv.ParseUint(strings.TrimPrefix(s, "+"), base, bits)
+		v = T(u)
+		if errors.Is(err, ErrSyntax) {
+			// ParseUint treats a negative number as a syntax error, but it is really out of range
+			if i, err2 := strconv.ParseInt(s, base, 64); err2 == nil && i == 0 {
+				return 0, nil
+			} else if err2 == nil || errors.Is(err2, ErrRange) {
+				err = ErrRange
+			}
+		}
+	}
+	if errors.Is(err, ErrRange) {
+		return 0, &RangeError[T]{Input: s, Min: minT, Max: maxT}
+	} else if err != nil {
+		return 0, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+	}
+	return v, nil
+}
+
 // AtoI is a convenience script for converting a string to various types of signed integers.
 // An invalid input will return zero, including if the input overflows the max size of the integer type.
+//
+// Use ParseInt to find out why a string could not be converted, or AtoIOr to return something other than zero.
 func AtoI[T constraints.Integer](s string) T {
-	var t T
-	signed := true
-	bitSize := 0
-	switch any(t).(type) {
-	case uint:
-		signed = false
-	case uint8:
-		signed = false
-		bitSize = 8
-	case uint16:
-		signed = false
-		bitSize = 16
-	case uint32:
-		signed = false
-		bitSize = 32
-	case uint64:
-		signed = false
-		bitSize = 64
-	case int8:
-		bitSize = 8
-	case int16:
-		bitSize = 16
-	case int32:
-		bitSize = 32
-	case int64:
-		bitSize = 64
+	v, _ := ParseInt[T](s)
+	return v
+}
+
+// AtoIOr is like AtoI, but returns def if s is not an integer that fits in T.
+func AtoIOr[T constraints.Integer](s string, def T) T {
+	v, err := ParseInt[T](s)
+	if err != nil {
+		return def
 	}
-	if signed {
-		v, err := strconv.ParseInt(s, 10, bitSize)
-		if err != nil {
-			return 0
+	return v
+}
+
+// AtoIBase is like AtoI, but parses s in the given base like ParseIntBase does.
+func AtoIBase[T constraints.Integer](s string, base int) T {
+	v, _ := ParseIntBase[T](s, base)
+	return v
+}
+
+// intInfo returns the smallest and largest values of T, the number of bits in T, and whether T is signed.
+func intInfo[T constraints.Integer]() (minT, maxT T, bits int, signed bool) {
+	var zero T
+	bits = int(unsafe.Sizeof(zero)) * 8
+	if ^zero < 0 {
+		maxT = T(uint64(1)<<(bits-1) - 1)
+		minT = -maxT - 1
+		return minT, maxT, bits, true
+	}
+	return 0, ^zero, bits, false
+}
+
+// ParseFloat parses s as a floating point number of type T, rounding it to the precision of T.
+// It accepts anything strconv.ParseFloat does, including exponents, "Inf" and "NaN".
+//
+// If s is not a number, the error wraps ErrSyntax. If s is too large to fit in T,
+// the error wraps ErrRange.
+func ParseFloat[T constraints.Float](s string) (T, error) {
+	var zero T
+	v, err := strconv.ParseFloat(s, int(unsafe.Sizeof(zero))*8)
+	if errors.Is(err, ErrRange) {
+		return 0, fmt.Errorf("parsing %q: %w", s, ErrRange)
+	} else if err != nil {
+		return 0, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+	}
+	return T(v), nil
+}
+
+// AtoF converts a string to a float32 or float64. An invalid input will return zero,
+// including if the input overflows the max size of the float type.
+//
+// Use ParseFloat to find out why a string could not be converted, or AtoFOr to return something other than zero.
+func AtoF[T constraints.Float](s string) T {
+	v, _ := ParseFloat[T](s)
+	return v
+}
+
+// AtoFOr is like AtoF, but returns def if s is not a number that fits in T.
+func AtoFOr[T constraints.Float](s string, def T) T {
+	v, err := ParseFloat[T](s)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// ParseDecimal parses s as a fixed point decimal number without any binary rounding. The value of the
+// number is mantissa / 10^scale, so "19.99" returns a mantissa of 1999 and a scale of 2, and "-0.50"
+// returns -50 and 2. The scale is the number of digits written after the decimal point.
+//
+// s may start with a + or -, and must otherwise only contain the digits 0-9 and at most one decimal point.
+// If s is not in that form, the error wraps ErrSyntax. If the mantissa does not fit in an int64,
+// the error wraps ErrRange.
+func ParseDecimal(s string) (mantissa int64, scale int, err error) {
+	digits := s
+	sign := ""
+	if len(digits) > 0 && (digits[0] == '+' || digits[0] == '-') {
+		sign, digits = digits[:1], digits[1:]
+	}
+	whole, frac, _ := strings.Cut(digits, ".")
+	if whole+frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return 0, 0, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+	}
+	mantissa, err = strconv.ParseInt(sign+whole+frac, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parsing %q: %w", s, ErrRange)
+	}
+	return mantissa, len(frac), nil
+}
+
+// ParseFixed parses s like ParseDecimal, and returns the value as a whole number of 1/10^scale units.
+// For example, ParseFixed("19.9", 2) returns 1990, the number of cents in 19.90.
+//
+// If s has more digits after the decimal point than scale, the extra digits must be zeros, so that
+// nothing is lost to rounding. Otherwise, or if the result does not fit in an int64, the error wraps ErrRange.
+func ParseFixed(s string, scale int) (int64, error) {
+	// Zeros past scale are dropped first, so that they cannot make the mantissa too big.
+	t := s
+	if whole, frac, found := strings.Cut(s, "."); found && scale >= 0 && len(frac) > scale {
+		frac = frac[:scale] + strings.TrimRight(frac[scale:], "0")
+		if frac == "" {
+			frac = "0"
 		}
-		return T(v)
-	} else {
-		v, err := strconv.ParseUint(s, 10, 0)
-		if err != nil {
-			return 0
+		t = whole + "." + frac
+	}
+	m, sc, err := ParseDecimal(t)
+	if errors.Is(err, ErrSyntax) {
+		return 0, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+	} else if err != nil {
+		return 0, fmt.Errorf("parsing %q: %w", s, ErrRange)
+	}
+	for ; sc > scale; sc-- {
+		if m%10 != 0 {
+			return 0, fmt.Errorf("parsing %q: more than %d decimal places: %w", s, scale, ErrRange)
+		}
+		m /= 10
+	}
+	for ; sc < scale; sc++ {
+		if m > math.MaxInt64/10 || m < math.MinInt64/10 {
+			return 0, fmt.Errorf("parsing %q: %w", s, ErrRange)
+		}
+		m *= 10
+	}
+	return m, nil
+}
+
+// isDigits returns true if s only contains the ASCII digits 0-9.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
 		}
-		return T(v)
 	}
+	return true
 }
@@ -1,10 +1,13 @@
 package strings
 
 import (
+	"errors"
 	"fmt"
 	"golang.org/x/exp/constraints"
+	"golang.org/x/text/language"
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -25,6 +28,9 @@ func TestExtractNumbers(t *testing.T) {
 		{"abc", "abc", ""},
 		{"a1c", "a1c", "1"},
 		{"a1c", "a1c", "1"},
+		{"roman", "Chapter Ⅻ", ""},
+		{"superscript", "x² + ½", ""},
+		{"arabic-indic", "١٢", "١٢"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -215,3 +221,384 @@ func ExampleAtoI() {
 
 	// Output: 23
 }
+
+func ExampleParseInt() {
+	_, err := ParseInt[uint8]("300")
+	var re *RangeError[uint8]
+	if errors.As(err, &re) {
+		fmt.Printf("must be between %d and %d\n", re.Min, re.Max)
+	}
+	_, err = ParseInt[int]("abc")
+	fmt.Println(errors.Is(err, ErrSyntax))
+	//Output: must be between 0 and 255
+	//true
+}
+
+func TestParseInt(t *testing.T) {
+	type port uint16
+
+	// success cases
+	if v, err := ParseInt[int8]("-128"); v != -128 || err != nil {
+		t.Errorf("ParseInt[int8](-128) = %d, %v", v, err)
+	}
+	if v, err := ParseInt[uint64]("18446744073709551615"); v != math.MaxUint64 || err != nil {
+		t.Errorf("ParseInt[uint64](max) = %d, %v", v, err)
+	}
+	if v, err := ParseInt[port]("8080"); v != 8080 || err != nil {
+		t.Errorf("ParseInt[port](8080) = %d, %v", v, err)
+	}
+	if v, err := ParseInt[uint]("-0"); v != 0 || err != nil {
+		t.Errorf("ParseInt[uint](-0) = %d, %v", v, err)
+	}
+	if v, err := ParseInt[uint8]("+7"); v != 7 || err != nil {
+		t.Errorf("ParseInt[uint8](+7) = %d, %v", v, err)
+	}
+
+	// range errors carry the bounds of T
+	_, err := ParseInt[int8]("128")
+	var re8 *RangeError[int8]
+	if !errors.As(err, &re8) || re8.Min != math.MinInt8 || re8.Max != math.MaxInt8 || re8.Input != "128" {
+		t.Errorf("ParseInt[int8](128) error = %v", err)
+	}
+	if !errors.Is(err, ErrRange) {
+		t.Errorf("ParseInt[int8](128) error %v is not ErrRange", err)
+	}
+
+	_, err = ParseInt[uint16]("65536")
+	var reu16 *RangeError[uint16]
+	if !errors.As(err, &reu16) || reu16.Min != 0 || reu16.Max != math.MaxUint16 {
+		t.Errorf("ParseInt[uint16](65536) error = %v", err)
+	}
+
+	_, err = ParseInt[port]("70000")
+	var rePort *RangeError[port]
+	if !errors.As(err, &rePort) || rePort.Max != math.MaxUint16 {
+		t.Errorf("ParseInt[port](70000) error = %v", err)
+	}
+
+	_, err = ParseInt[uint32]("-1")
+	var reu32 *RangeError[uint32]
+	if !errors.As(err, &reu32) {
+		t.Errorf("ParseInt[uint32](-1) error = %v", err)
+	}
+
+	_, err = ParseInt[uint64]("-99999999999999999999")
+	if !errors.Is(err, ErrRange) {
+		t.Errorf("ParseInt[uint64](-99999999999999999999) error = %v", err)
+	}
+
+	// syntax errors
+	for _, s := range []string{"", "abc", "12abc", "1.5", " 1", "-", "--1"} {
+		if v, err := ParseInt[uint8](s); v != 0 || !errors.Is(err, ErrSyntax) {
+			t.Errorf("ParseInt[uint8](%q) = %d, %v", s, v, err)
+		}
+		if v, err := ParseInt[int](s); v != 0 || !errors.Is(err, ErrSyntax) {
+			t.Errorf("ParseInt[int](%q) = %d, %v", s, v, err)
+		}
+	}
+}
+
+func TestAtoI_namedType(t *testing.T) {
+	type port uint16
+	if v := AtoI[port]("70000"); v != 0 {
+		t.Errorf("AtoI[port](70000) = %d, want 0", v)
+	}
+	if v := AtoI[port]("443"); v != 443 {
+		t.Errorf("AtoI[port](443) = %d, want 443", v)
+	}
+}
+
+func TestAtoIOr(t *testing.T) {
+	if v := AtoIOr[int]("12", -1); v != 12 {
+		t.Errorf("AtoIOr(12) = %d", v)
+	}
+	if v := AtoIOr[int]("0", -1); v != 0 {
+		t.Errorf("AtoIOr(0) = %d", v)
+	}
+	if v := AtoIOr[int]("abc", -1); v != -1 {
+		t.Errorf("AtoIOr(abc) = %d", v)
+	}
+	if v := AtoIOr[uint8]("256", 255); v != 255 {
+		t.Errorf("AtoIOr[uint8](256) = %d", v)
+	}
+}
+
+func TestParseFloat(t *testing.T) {
+	if v, err := ParseFloat[float64]("19.99"); v != 19.99 || err != nil {
+		t.Errorf("ParseFloat[float64](19.99) = %v, %v", v, err)
+	}
+	if v, err := ParseFloat[float32]("0.1"); v != float32(0.1) || err != nil {
+		t.Errorf("ParseFloat[float32](0.1) = %v, %v", v, err)
+	}
+	if v, err := ParseFloat[float32]("1e39"); v != 0 || !errors.Is(err, ErrRange) {
+		t.Errorf("ParseFloat[float32](1e39) = %v, %v", v, err)
+	}
+	if v, err := ParseFloat[float64]("1e39"); v != 1e39 || err != nil {
+		t.Errorf("ParseFloat[float64](1e39) = %v, %v", v, err)
+	}
+	if v, err := ParseFloat[float64]("1e400"); v != 0 || !errors.Is(err, ErrRange) {
+		t.Errorf("ParseFloat[float64](1e400) = %v, %v", v, err)
+	}
+	for _, s := range []string{"", "abc", "1.2.3", "1,5"} {
+		if _, err := ParseFloat[float64](s); !errors.Is(err, ErrSyntax) {
+			t.Errorf("ParseFloat[float64](%q) error = %v", s, err)
+		}
+	}
+}
+
+func TestAtoF(t *testing.T) {
+	if v := AtoF[float32]("-2.5"); v != -2.5 {
+		t.Errorf("AtoF[float32](-2.5) = %v", v)
+	}
+	if v := AtoF[float64]("x"); v != 0 {
+		t.Errorf("AtoF[float64](x) = %v", v)
+	}
+	if v := AtoFOr[float32]("1e39", -1); v != -1 {
+		t.Errorf("AtoFOr[float32](1e39) = %v", v)
+	}
+}
+
+func ExampleParseDecimal() {
+	m, scale, _ := ParseDecimal("19.99")
+	fmt.Println(m, scale)
+	cents, _ := ParseFixed("19.9", 2)
+	fmt.Println(cents)
+	//Output: 1999 2
+	//1990
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		s         string
+		mantissa  int64
+		scale     int
+		wantError error
+	}{
+		{"0", 0, 0, nil},
+		{"19.99", 1999, 2, nil},
+		{"-0.50", -50, 2, nil},
+		{"+3.", 3, 0, nil},
+		{".5", 5, 1, nil},
+		{"0.1", 1, 1, nil},
+		{"-9223372036854775808", math.MinInt64, 0, nil},
+		{"922337203685477580.7", math.MaxInt64, 1, nil},
+		{"9223372036854775808", 0, 0, ErrRange},
+		{"", 0, 0, ErrSyntax},
+		{"-", 0, 0, ErrSyntax},
+		{".", 0, 0, ErrSyntax},
+		{"1.2.3", 0, 0, ErrSyntax},
+		{"1e3", 0, 0, ErrSyntax},
+		{"1,000", 0, 0, ErrSyntax},
+		{" 1", 0, 0, ErrSyntax},
+		{"--1", 0, 0, ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			m, scale, err := ParseDecimal(tt.s)
+			if m != tt.mantissa || scale != tt.scale || !errors.Is(err, tt.wantError) {
+				t.Errorf("ParseDecimal(%q) = %d, %d, %v, want %d, %d, %v", tt.s, m, scale, err, tt.mantissa, tt.scale, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestParseFixed(t *testing.T) {
+	tests := []struct {
+		s         string
+		scale     int
+		want      int64
+		wantError error
+	}{
+		{"19.99", 2, 1999, nil},
+		{"19.9", 2, 1990, nil},
+		{"19", 2, 1900, nil},
+		{"-0.05", 2, -5, nil},
+		{"19.990", 2, 1999, nil},
+		{"19.995", 2, 0, ErrRange},
+		{"12.5", 0, 0, ErrRange},
+		{"92233720368547758.07", 2, math.MaxInt64, nil},
+		{"92233720368547758.08", 2, 0, ErrRange},
+		{"92233720368547759", 2, 0, ErrRange},
+		{"abc", 2, 0, ErrSyntax},
+		{"0.10000000000000000000", 2, 10, nil},
+		{"92233720368547758.0700000000", 2, math.MaxInt64, nil},
+		{"-.000000000000000000000", 0, 0, nil},
+		{"5.000000000000000000001", 2, 0, ErrRange},
+		{"1.0x000000000000000000", 2, 0, ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := ParseFixed(tt.s, tt.scale)
+			if got != tt.want || !errors.Is(err, tt.wantError) {
+				t.Errorf("ParseFixed(%q, %d) = %d, %v, want %d, %v", tt.s, tt.scale, got, err, tt.want, tt.wantError)
+			}
+		})
+	}
+}
+
+func ExampleParseIntBase() {
+	mask, _ := ParseIntBase[uint32]("0xFF_00", 0)
+	perm, _ := ParseIntBase[uint16]("0o755", 0)
+	id, _ := ParseIntBase[int64]("zz", 36)
+	fmt.Println(mask, perm, id)
+	//Output: 65280 493 1295
+}
+
+func TestParseIntBase(t *testing.T) {
+	tests := []struct {
+		s         string
+		base      int
+		want      int64
+		wantError error
+	}{
+		{"0x1F", 0, 31, nil},
+		{"0X1f", 0, 31, nil},
+		{"-0x80", 0, -128, nil},
+		{"+0x7f", 0, 127, nil},
+		{"0o17", 0, 15, nil},
+		{"017", 0, 15, nil},
+		{"0b1010", 0, 10, nil},
+		{"0b_0101_0101", 0, 85, nil},
+		{"1_00", 0, 100, nil},
+		{"0", 0, 0, nil},
+		{"0x80", 0, 0, ErrRange},
+		{"-0x81", 0, 0, ErrRange},
+		{"0x", 0, 0, ErrSyntax},
+		{"0b102", 0, 0, ErrSyntax},
+		{"08", 0, 0, ErrSyntax},
+		{"1__00", 0, 0, ErrSyntax},
+		{"_100", 0, 0, ErrSyntax},
+		{"1_00", 10, 0, ErrSyntax},
+		{"ff", 16, 0, ErrRange},
+		{"7f", 16, 127, nil},
+		{"-1111111", 2, -127, nil},
+		{"z", 36, 35, nil},
+		{"Z", 36, 35, nil},
+		{"3j", 20, 79, nil},
+		{"2", 2, 0, ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s base %d", tt.s, tt.base), func(t *testing.T) {
+			got, err := ParseIntBase[int8](tt.s, tt.base)
+			if int64(got) != tt.want || !errors.Is(err, tt.wantError) {
+				t.Errorf("ParseIntBase[int8](%q, %d) = %d, %v, want %d, %v", tt.s, tt.base, got, err, tt.want, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestParseIntBase_unsigned(t *testing.T) {
+	if v, err := ParseIntBase[uint16]("0xFFFF", 0); v != math.MaxUint16 || err != nil {
+		t.Errorf("ParseIntBase[uint16](0xFFFF) = %d, %v", v, err)
+	}
+	if v, err := ParseIntBase[uint16]("+0o777", 0); v != 511 || err != nil {
+		t.Errorf("ParseIntBase[uint16](+0o777) = %d, %v", v, err)
+	}
+	_, err := ParseIntBase[uint16]("0x1_0000", 0)
+	var re *RangeError[uint16]
+	if !errors.As(err, &re) || re.Max != math.MaxUint16 {
+		t.Errorf("ParseIntBase[uint16](0x1_0000) error = %v", err)
+	}
+	if _, err := ParseIntBase[uint16]("-0x1", 0); !errors.Is(err, ErrRange) {
+		t.Errorf("ParseIntBase[uint16](-0x1) error = %v", err)
+	}
+	if v, err := ParseIntBase[uint64]("ffffffffffffffff", 16); v != math.MaxUint64 || err != nil {
+		t.Errorf("ParseIntBase[uint64](ffffffffffffffff, 16) = %d, %v", v, err)
+	}
+	for _, base := range []int{1, -1, 37} {
+		if _, err := ParseIntBase[int]("1", base); err == nil {
+			t.Errorf("ParseIntBase(1, %d) did not return an error", base)
+		}
+	}
+	if v := AtoIBase[uint8]("0b11111111", 0); v != 255 {
+		t.Errorf("AtoIBase[uint8](0b11111111) = %d", v)
+	}
+	if v := AtoIBase[uint8]("0x100", 0); v != 0 {
+		t.Errorf("AtoIBase[uint8](0x100) = %d", v)
+	}
+}
+
+func ExampleFindNumbers() {
+	for _, m := range FindNumbers("Call 555-1234 ext. 12, or pay $1,299.99 less 15%") {
+		fmt.Println(m.Start, m.Text, m.Value, m.Percent)
+	}
+	//Output: 5 555 555 false
+	//9 1234 1234 false
+	//19 12 12 false
+	//31 1,299.99 1299.99 false
+	//45 15% 15 true
+}
+
+func TestFindNumbers(t *testing.T) {
+	type match struct {
+		text    string
+		number  string
+		integer bool
+		percent bool
+	}
+	tests := []struct {
+		name string
+		s    string
+		opts FindNumbersOptions
+		want []match
+	}{
+		{"empty", "", FindNumbersOptions{}, nil},
+		{"none", "no numbers here", FindNumbersOptions{}, nil},
+		{"phone", "Call 555-1234 ext. 12", FindNumbersOptions{}, []match{
+			{"555", "555", true, false}, {"1234", "1234", true, false}, {"12", "12", true, false}}},
+		{"signs", "from -3 to +5", FindNumbersOptions{}, []match{
+			{"-3", "-3", true, false}, {"+5", "5", true, false}}},
+		{"range", "3-5 days", FindNumbersOptions{}, []match{
+			{"3", "3", true, false}, {"5", "5", true, false}}},
+		{"letter before sign", "A-5", FindNumbersOptions{}, []match{{"5", "5", true, false}}},
+		{"thousands", "1,234,567 units", FindNumbersOptions{}, []match{{"1,234,567", "1234567", true, false}}},
+		{"list", "1,23,4", FindNumbersOptions{}, []match{
+			{"1", "1", true, false}, {"23", "23", true, false}, {"4", "4", true, false}}},
+		{"long group", "1234,567", FindNumbersOptions{}, []match{
+			{"1234", "1234", true, false}, {"567", "567", true, false}}},
+		{"four digit group", "1,2345", FindNumbersOptions{}, []match{
+			{"1", "1", true, false}, {"2345", "2345", true, false}}},
+		{"decimal", "pi is 3.14159.", FindNumbersOptions{}, []match{{"3.14159", "3.14159", false, false}}},
+		{"leading decimal", "about .5 kg", FindNumbersOptions{}, []match{{".5", ".5", false, false}}},
+		{"negative decimal", "-0.25", FindNumbersOptions{}, []match{{"-0.25", "-0.25", false, false}}},
+		{"exponent", "6.02e23 atoms, 1E-3 m", FindNumbersOptions{}, []match{
+			{"6.02e23", "6.02e23", false, false}, {"1E-3", "1e-3", false, false}}},
+		{"not exponent", "3em", FindNumbersOptions{}, []match{{"3", "3", true, false}}},
+		{"percent", "15% off, 2.5 % fee", FindNumbersOptions{}, []match{
+			{"15%", "15", true, true}, {"2.5 %", "2.5", false, true}}},
+		{"version", "v1.2.3", FindNumbersOptions{}, []match{{"1.2", "1.2", false, false}, {"3", "3", true, false}}},
+		{"native digits", "السعر ١٢٣ ريال", FindNumbersOptions{}, []match{{"١٢٣", "123", true, false}}},
+		{"integers only", "2 boxes of 3.5 kg at 10%", FindNumbersOptions{IntegersOnly: true}, []match{
+			{"2", "2", true, false}, {"10%", "10", true, true}}},
+		{"too big", "1e999 and 5", FindNumbersOptions{}, []match{
+			{"1e999", "1e999", false, false}, {"5", "5", true, false}}},
+		{"too small", "-1e999", FindNumbersOptions{}, []match{{"-1e999", "-1e999", false, false}}},
+		{"too many digits", "1" + strings.Repeat("0", 400), FindNumbersOptions{}, []match{
+			{"1" + strings.Repeat("0", 400), "1" + strings.Repeat("0", 400), true, false}}},
+		{"german", "1.234,50 € und 3,5 kg", FindNumbersOptions{Language: language.German}, []match{
+			{"1.234,50", "1234.50", false, false}, {"3,5", "3.5", false, false}}},
+		{"french", "1\u202f234,5", FindNumbersOptions{Language: language.French}, []match{{"1\u202f234,5", "1234.5", false, false}}},
+		{"french spaces", "5 123", FindNumbersOptions{Language: language.French}, []match{
+			{"5", "5", true, false}, {"123", "123", true, false}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindNumbersWith(tt.s, tt.opts)
+			if len(got) != len(tt.want) {
+				t.Fatalf("FindNumbersWith(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+			for i, m := range got {
+				w := tt.want[i]
+				if m.Text != w.text || m.Number != w.number || m.Integer != w.integer || m.Percent != w.percent {
+					t.Errorf("FindNumbersWith(%q)[%d] = %+v, want %+v", tt.s, i, m, w)
+				}
+				if tt.s[m.Start:m.End] != m.Text {
+					t.Errorf("FindNumbersWith(%q)[%d] offsets %d:%d do not match %q", tt.s, i, m.Start, m.End, m.Text)
+				}
+				if v, _ := strconv.ParseFloat(w.number, 64); m.Value != v {
+					t.Errorf("FindNumbersWith(%q)[%d].Value = %v, want %v", tt.s, i, m.Value, v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package strings
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// effLargeWordlist is the EFF large wordlist for passphrases, in the diceware format of a dice roll and a word
+// on each line. It is from https://www.eff.org/files/2016/07/18/eff_large_wordlist.txt and is licensed under
+// CC BY 3.0 US by the Electronic Frontier Foundation.
+//
+//go:embed eff_large_wordlist.txt
+var effLargeWordlist string
+
+// Wordlist is a list of distinct words that passphrases are made from.
+type Wordlist []string
+
+// EFFWordlist returns the EFF large wordlist of 7776 words, which gives about 12.9 bits of entropy per word.
+// The words are easy to spell and say, and no word is the start of another.
+// The list is shared, so it must not be changed.
+func EFFWordlist() Wordlist {
+	return effWordlist()
+}
+
+var effWordlist = sync.OnceValue(func() Wordlist {
+	w, err := LoadWordlist(strings.NewReader(effLargeWordlist))
+	if err != nil {
+		panic(err)
+	}
+	return w
+})
+
+// LoadWordlist reads a wordlist from r, with one word on each line. Lines can also be in the diceware format
+// of a dice roll followed by the word, like "11111	abacus", in which case the roll is ignored.
+// Blank lines are skipped.
+//
+// If a line is not a word, a word is repeated, or there are fewer than two words, the error wraps ErrSyntax.
+func LoadWordlist(r io.Reader) (Wordlist, error) {
+	var words Wordlist
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && isDigits(fields[0]) {
+			fields = fields[1:]
+		}
+		switch {
+		case len(fields) == 0:
+			continue
+		case len(fields) > 1:
+			return nil, fmt.Errorf("wordlist line %d: %q is not a word: %w", line, scanner.Text(), ErrSyntax)
+		case seen[fields[0]]:
+			return nil, fmt.Errorf("wordlist line %d: %q is repeated: %w", line, fields[0], ErrSyntax)
+		}
+		seen[fields[0]] = true
+		words = append(words, fields[0])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(words) < 2 {
+		return nil, fmt.Errorf("wordlist has %d words, and needs at least 2: %w", len(words), ErrSyntax)
+	}
+	return words, nil
+}
+
+// PassphraseOptions control how Passphrase makes a passphrase.
+type PassphraseOptions struct {
+	// Separator is put between the words. If empty, a space is used.
+	Separator string
+	// Capitalize makes the first letter of each word upper case.
+	Capitalize bool
+	// Digits is the number of random digits added to the end of one of the words, picked at random.
+	Digits int
+	// Wordlist is the list of words to pick from. If nil, EFFWordlist is used.
+	Wordlist Wordlist
+}
+
+// Passphrase makes a passphrase of the given number of words picked at random from a wordlist using crypto/rand,
+// like "unplug crispy rewind stipend flavored". Passphrases are easier to say, remember and type than
+// random strings of the same strength.
+//
+// It also returns the entropy of the passphrase in bits, which is the number of bits of randomness an attacker
+// who knows the wordlist and options has to guess. Each word from EFFWordlist adds about 12.9 bits,
+// and 6 words are commonly recommended. Capitalizing adds nothing, since every word is capitalized.
+//
+// If words is less than 1 or Digits is negative, the error wraps ErrRange.
+func Passphrase(words int, opts PassphraseOptions) (phrase string, bits float64, err error) {
+	return cryptoGenerator.Passphrase(words, opts)
+}
+
+// Passphrase makes a passphrase using the randomness of g. See the Passphrase function.
+// If the reader of g returns an error, it is returned.
+func (g *Generator) Passphrase(words int, opts PassphraseOptions) (phrase string, bits float64, err error) {
+	if words < 1 || opts.Digits < 0 {
+		return "", 0, fmt.Errorf("passphrase of %d words and %d digits: %w", words, opts.Digits, ErrRange)
+	}
+	list := opts.Wordlist
+	if list == nil {
+		list = EFFWordlist()
+	}
+	if len(list) < 2 {
+		return "", 0, fmt.Errorf("wordlist has %d words, and needs at least 2: %w", len(list), ErrSyntax)
+	}
+	sep := opts.Separator
+	if sep == "" {
+		sep = " "
+	}
+
+	parts := make([]string, words)
+	for i := range parts {
+		k, err := g.intN(len(list))
+		if err != nil {
+			return "", 0, err
+		}
+		parts[i] = list[k]
+		if opts.Capitalize {
+			r, size := utf8.DecodeRuneInString(parts[i])
+			parts[i] = string(unicode.ToTitle(r)) + parts[i][size:]
+		}
+	}
+	bits = float64(words) * math.Log2(float64(len(list)))
+	if opts.Digits > 0 {
+		k, err := g.intN(words)
+		if err != nil {
+			return "", 0, err
+		}
+		digits := make([]byte, opts.Digits)
+		if err := g.Fill(digits, Numbers); err != nil {
+			return "", 0, err
+		}
+		parts[k] += string(digits)
+		bits += float64(opts.Digits)*math.Log2(10) + math.Log2(float64(words))
+	}
+	return strings.Join(parts, sep), bits, nil
+}
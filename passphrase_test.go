@@ -0,0 +1,108 @@
+package strings
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func ExamplePassphrase() {
+	phrase, bits, _ := Passphrase(6, PassphraseOptions{})
+	fmt.Println(len(strings.Fields(phrase)), math.Round(bits))
+	//Output: 6 78
+}
+
+func TestEFFWordlist(t *testing.T) {
+	w := EFFWordlist()
+	if len(w) != 7776 {
+		t.Fatalf("EFFWordlist() has %d words", len(w))
+	}
+	if w[0] != "abacus" || w[len(w)-1] != "zoom" {
+		t.Errorf("EFFWordlist() runs from %q to %q", w[0], w[len(w)-1])
+	}
+}
+
+func TestLoadWordlist(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    Wordlist
+		wantErr bool
+	}{
+		{"plain", "apple\nbanana\n\ncherry", Wordlist{"apple", "banana", "cherry"}, false},
+		{"diceware", "11\tapple\n12\tbanana\r\n", Wordlist{"apple", "banana"}, false},
+		{"spaces", "  apple \n banana", Wordlist{"apple", "banana"}, false},
+		{"two words", "apple pie\nbanana", nil, true},
+		{"repeated", "apple\nbanana\napple", nil, true},
+		{"one word", "apple", nil, true},
+		{"empty", "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadWordlist(strings.NewReader(tt.in))
+			if tt.wantErr {
+				if !errors.Is(err, ErrSyntax) {
+					t.Errorf("LoadWordlist() = %q, %v, want ErrSyntax", got, err)
+				}
+			} else if err != nil || strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("LoadWordlist() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPassphrase(t *testing.T) {
+	g := NewSeededGenerator(3)
+	list := Wordlist{"red", "green", "blue", "gold"}
+
+	phrase, bits, err := g.Passphrase(5, PassphraseOptions{Wordlist: list})
+	if err != nil || len(strings.Split(phrase, " ")) != 5 || bits != 10 {
+		t.Errorf("Passphrase(5) = %q, %v, %v", phrase, bits, err)
+	}
+
+	phrase, _, _ = g.Passphrase(4, PassphraseOptions{Wordlist: list, Separator: "-", Capitalize: true})
+	for _, w := range strings.Split(phrase, "-") {
+		if !unicode.IsUpper(rune(w[0])) || !strings.Contains("Red Green Blue Gold", w) {
+			t.Errorf("Passphrase(Capitalize) = %q", phrase)
+		}
+	}
+
+	phrase, bits, _ = g.Passphrase(4, PassphraseOptions{Wordlist: list, Separator: ".", Digits: 3})
+	if n := countIn(phrase, Numbers); n != 3 {
+		t.Errorf("Passphrase(Digits: 3) = %q", phrase)
+	}
+	if want := 8 + 3*math.Log2(10) + 2; math.Abs(bits-want) > 1e-9 {
+		t.Errorf("Passphrase(Digits: 3) bits = %v, want %v", bits, want)
+	}
+	var withDigits int
+	for _, w := range strings.Split(phrase, ".") {
+		if strings.ContainsAny(w, Numbers) {
+			withDigits++
+			if !strings.Contains(list[0]+list[1]+list[2]+list[3], strings.TrimRight(w, Numbers)) {
+				t.Errorf("Passphrase(Digits: 3) = %q", phrase)
+			}
+		}
+	}
+	if withDigits != 1 {
+		t.Errorf("Passphrase(Digits: 3) = %q, want the digits on one word", phrase)
+	}
+
+	if _, _, err := g.Passphrase(0, PassphraseOptions{}); !errors.Is(err, ErrRange) {
+		t.Errorf("Passphrase(0) error = %v", err)
+	}
+	if _, _, err := g.Passphrase(3, PassphraseOptions{Digits: -1}); !errors.Is(err, ErrRange) {
+		t.Errorf("Passphrase(Digits: -1) error = %v", err)
+	}
+	if _, _, err := g.Passphrase(3, PassphraseOptions{Wordlist: Wordlist{"one"}}); !errors.Is(err, ErrSyntax) {
+		t.Errorf("Passphrase() with one word error = %v", err)
+	}
+	for _, limit := range []int{0, 3} {
+		g := NewGenerator(&countingReader{limit: limit})
+		if _, _, err := g.Passphrase(2, PassphraseOptions{Wordlist: list, Digits: 2}); err == nil {
+			t.Errorf("Passphrase() with %d random bytes did not return the error of the reader", limit)
+		}
+	}
+}
@@ -0,0 +1,366 @@
+package strings
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// AmbiguousChars are the characters left out of passwords by PasswordPolicy.ExcludeAmbiguous, because they are
+// easily confused with each other: lower case l, upper case I and the number 1, and upper case O and the number 0.
+const AmbiguousChars = "lI1O0"
+
+// DefaultPasswordLength is the length of the passwords made with a PasswordPolicy that has no MinLength.
+const DefaultPasswordLength = 16
+
+// ErrUnsatisfiablePolicy is returned, wrapped with the reasons, when no password can follow a PasswordPolicy.
+var ErrUnsatisfiablePolicy = errors.New("password policy cannot be satisfied")
+
+// PasswordPolicy describes the passwords made by Generate and Generator.PasswordWith.
+//
+// Passwords are made of lower case letters, upper case letters, digits and symbols. The zero PasswordPolicy makes
+// passwords of DefaultPasswordLength characters from all four classes, with no minimum number from any class.
+type PasswordPolicy struct {
+	// MinLength is the shortest password to make. Zero uses DefaultPasswordLength, or MaxLength if that is shorter.
+	// The password is made longer if needed to fit the class minimums.
+	MinLength int
+	// MaxLength is the longest password to make. The length is picked at random between MinLength and MaxLength.
+	// Zero makes passwords of exactly MinLength characters, or of the sum of the class minimums if that is greater.
+	MaxLength int
+
+	// MinLower is the least number of lower case letters.
+	MinLower int
+	// MinUpper is the least number of upper case letters.
+	MinUpper int
+	// MinDigits is the least number of digits.
+	MinDigits int
+	// MinSymbols is the least number of symbols.
+	MinSymbols int
+
+	// Symbols are the symbols that can be used, which can be any Unicode characters, like "€£".
+	// If empty, PasswordSym is used.
+	Symbols string
+	// NoSymbols leaves symbols out entirely.
+	NoSymbols bool
+	// Exclude are characters that are never used, from any class.
+	Exclude string
+	// ExcludeAmbiguous leaves out AmbiguousChars.
+	ExcludeAmbiguous bool
+
+	// NoRepeat prevents the same character from appearing twice in a row, like "aa".
+	NoRepeat bool
+	// NoSequence prevents three characters in a row that go up or down by one, like "abc", "CBA" or "789".
+	NoSequence bool
+}
+
+// Generate makes a password that follows policy, using crypto/rand.
+//
+// If no password can follow the policy, the error wraps ErrUnsatisfiablePolicy and explains which rules conflict.
+func Generate(policy PasswordPolicy) (string, error) {
+	return cryptoGenerator.PasswordWith(policy)
+}
+
+// PasswordWith makes a password that follows policy, using the randomness of g. See Generate.
+// If the reader of g returns an error, it is returned.
+func (g *Generator) PasswordWith(policy PasswordPolicy) (string, error) {
+	classes, err := policy.classes()
+	if err != nil {
+		return "", err
+	}
+	minLen, maxLen, err := policy.lengths(classes)
+	if err != nil {
+		return "", err
+	}
+
+	var pool []rune
+	for _, c := range classes {
+		pool = append(pool, c.chars...)
+	}
+	if policy.NoRepeat && len(pool) == 1 && minLen > 1 {
+		return "", fmt.Errorf("%w: NoRepeat needs at least 2 characters, but only %q is allowed", ErrUnsatisfiablePolicy, string(pool))
+	}
+
+	n := minLen
+	if maxLen > minLen {
+		k, err := g.intN(maxLen - minLen + 1)
+		if err != nil {
+			return "", err
+		}
+		n += k
+	}
+	b := passwordBuilder{g: g, policy: policy, classes: classes}
+	// If the length picked cannot follow the rules, another length might.
+	for _, length := range append([]int{n}, rangeWithout(minLen, maxLen, n)...) {
+		ok, err := b.build(length)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return string(b.password), nil
+		}
+	}
+
+	rules := "NoRepeat and NoSequence"
+	if !policy.NoSequence {
+		rules = "NoRepeat"
+	} else if !policy.NoRepeat {
+		rules = "NoSequence"
+	}
+	size := fmt.Sprintf("%d", minLen)
+	if maxLen > minLen {
+		size = fmt.Sprintf("%d to %d", minLen, maxLen)
+	}
+	return "", fmt.Errorf("%w: no password of %s characters can follow %s and have the class minimums",
+		ErrUnsatisfiablePolicy, size, rules)
+}
+
+// rangeWithout returns the numbers from lo to hi, leaving out skip.
+func rangeWithout(lo, hi, skip int) []int {
+	var r []int
+	for i := lo; i <= hi; i++ {
+		if i != skip {
+			r = append(r, i)
+		}
+	}
+	return r
+}
+
+// passwordBuilder makes a password one character at a time, picking only characters that follow the
+// NoRepeat and NoSequence rules. When there is no such character, it goes back and picks a different one
+// earlier, so it only fails if no password of the length can follow the policy.
+type passwordBuilder struct {
+	g        *Generator
+	policy   PasswordPolicy
+	classes  []passwordClass
+	password []rune
+	need     [4]int // the number of characters of each class still needed
+	dead     map[passwordState]bool
+}
+
+// passwordState is what decides whether the rest of a password can be built: how much of it is built,
+// the characters still needed, the last character and whether it went up or down by one from the one before,
+// which is all the rules look at.
+type passwordState struct {
+	pos  int
+	need [4]int
+	last rune
+	step rune
+}
+
+// build makes a password of n characters, and returns false if there is none.
+func (b *passwordBuilder) build(n int) (bool, error) {
+	b.password = b.password[:0]
+	for i, c := range b.classes {
+		b.need[i] = c.min
+	}
+	b.dead = make(map[passwordState]bool)
+	return b.fill(n)
+}
+
+// fill adds characters until the password has n of them.
+func (b *passwordBuilder) fill(n int) (bool, error) {
+	pos := len(b.password)
+	if pos == n {
+		return true, nil
+	}
+	state := passwordState{pos: pos, need: b.need, last: -1}
+	if pos >= 1 {
+		state.last = b.password[pos-1]
+	}
+	if pos >= 2 {
+		if d := b.password[pos-1] - b.password[pos-2]; d == 1 || d == -1 {
+			state.step = d
+		}
+	}
+	if b.dead[state] || !b.possible(n) {
+		return false, nil
+	}
+
+	var required int
+	for _, m := range b.need {
+		required += m
+	}
+	type candidate struct {
+		r     rune
+		class int
+	}
+	var candidates []candidate
+	for i, c := range b.classes {
+		if required == n-pos && b.need[i] == 0 {
+			// every remaining character is needed for a class minimum
+			continue
+		}
+		for _, r := range c.chars {
+			if b.allowed(r) {
+				candidates = append(candidates, candidate{r, i})
+			}
+		}
+	}
+	for len(candidates) > 0 {
+		k, err := b.g.intN(len(candidates))
+		if err != nil {
+			return false, err
+		}
+		c := candidates[k]
+		candidates[k] = candidates[len(candidates)-1]
+		candidates = candidates[:len(candidates)-1]
+
+		b.password = append(b.password, c.r)
+		needed := b.need[c.class] > 0
+		if needed {
+			b.need[c.class]--
+		}
+		if ok, err := b.fill(n); ok || err != nil {
+			return ok, err
+		}
+		b.password = b.password[:pos]
+		if needed {
+			b.need[c.class]++
+		}
+	}
+	b.dead[state] = true
+	return false, nil
+}
+
+// possible returns false if the password clearly cannot be finished with n characters. A class of one character
+// can fill at most every other place when NoRepeat is on. Without this, finding that out would mean trying
+// every way of placing the other characters.
+func (b *passwordBuilder) possible(n int) bool {
+	if !b.policy.NoRepeat {
+		return true
+	}
+	pos := len(b.password)
+	for i, c := range b.classes {
+		if len(c.chars) != 1 {
+			continue
+		}
+		most := (n - pos + 1) / 2
+		if pos > 0 && b.password[pos-1] == c.chars[0] {
+			most = (n - pos) / 2
+		}
+		if b.need[i] > most {
+			return false
+		}
+	}
+	return true
+}
+
+// allowed returns true if adding r to the password follows the NoRepeat and NoSequence rules.
+func (b *passwordBuilder) allowed(r rune) bool {
+	pw := b.password
+	n := len(pw)
+	if b.policy.NoRepeat && n >= 1 && r == pw[n-1] {
+		return false
+	}
+	if b.policy.NoSequence && n >= 2 {
+		d := r - pw[n-1]
+		if (d == 1 || d == -1) && pw[n-1]-pw[n-2] == d {
+			return false
+		}
+	}
+	return true
+}
+
+// passwordClass is one of the classes of characters in a password, with the least number of them to use.
+type passwordClass struct {
+	name  string
+	chars []rune
+	min   int
+}
+
+// classes returns the classes of characters the policy allows, after removing the excluded characters.
+// Characters are only in the first class they appear in, so a custom symbol set that includes letters
+// does not make those letters more likely. Symbols and Exclude can hold any Unicode characters.
+func (p PasswordPolicy) classes() ([]passwordClass, error) {
+	symbols := p.Symbols
+	if symbols == "" {
+		symbols = PasswordSym
+	}
+	if p.NoSymbols {
+		symbols = ""
+	}
+	exclude := p.Exclude
+	if p.ExcludeAmbiguous {
+		exclude += AmbiguousChars
+	}
+
+	var reasons []string
+	if !utf8.ValidString(p.Symbols) {
+		reasons = append(reasons, "Symbols is not valid UTF-8")
+	}
+	if !utf8.ValidString(p.Exclude) {
+		reasons = append(reasons, "Exclude is not valid UTF-8")
+	}
+	var classes []passwordClass
+	seen := make(map[rune]bool)
+	for _, c := range []struct {
+		name  string
+		chars string
+		min   int
+	}{
+		{"lower case letters", AlphaLower, p.MinLower},
+		{"upper case letters", AlphaUpper, p.MinUpper},
+		{"digits", Numbers, p.MinDigits},
+		{"symbols", symbols, p.MinSymbols},
+	} {
+		if c.min < 0 {
+			reasons = append(reasons, fmt.Sprintf("the minimum number of %s is negative", c.name))
+		}
+		class := passwordClass{name: c.name, min: c.min}
+		for _, r := range c.chars {
+			if !seen[r] && !strings.ContainsRune(exclude, r) {
+				seen[r] = true
+				class.chars = append(class.chars, r)
+			}
+		}
+		if len(class.chars) == 0 {
+			if c.min > 0 {
+				reasons = append(reasons, fmt.Sprintf("%d %s are required, but all of them are excluded", c.min, c.name))
+			}
+			continue
+		}
+		classes = append(classes, class)
+	}
+	if len(classes) == 0 && len(reasons) == 0 {
+		reasons = append(reasons, "every character is excluded")
+	}
+	if len(reasons) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnsatisfiablePolicy, strings.Join(reasons, "; "))
+	}
+	return classes, nil
+}
+
+// lengths returns the shortest and longest passwords the policy allows.
+func (p PasswordPolicy) lengths(classes []passwordClass) (minLen, maxLen int, err error) {
+	var required int
+	for _, c := range classes {
+		required += c.min
+	}
+	minLen = p.MinLength
+	if minLen == 0 {
+		minLen = DefaultPasswordLength
+		if p.MaxLength > 0 {
+			minLen = min(minLen, p.MaxLength)
+		}
+	}
+
+	var reasons []string
+	if p.MinLength < 0 || p.MaxLength < 0 {
+		reasons = append(reasons, "the length is negative")
+	}
+	if p.MaxLength > 0 && p.MaxLength < minLen {
+		reasons = append(reasons, fmt.Sprintf("MaxLength %d is less than MinLength %d", p.MaxLength, minLen))
+	} else if p.MaxLength > 0 && p.MaxLength < required {
+		reasons = append(reasons, fmt.Sprintf("the class minimums need %d characters, but MaxLength is %d", required, p.MaxLength))
+	}
+	if len(reasons) > 0 {
+		return 0, 0, fmt.Errorf("%w: %s", ErrUnsatisfiablePolicy, strings.Join(reasons, "; "))
+	}
+	minLen = max(minLen, required)
+	maxLen = p.MaxLength
+	if maxLen == 0 {
+		maxLen = minLen
+	}
+	return minLen, maxLen, nil
+}
@@ -0,0 +1,139 @@
+package strings
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func ExampleGenerate() {
+	pw, _ := Generate(PasswordPolicy{MinLength: 20, MinDigits: 2, MinSymbols: 2, ExcludeAmbiguous: true})
+	fmt.Println(len(pw))
+
+	_, err := Generate(PasswordPolicy{MaxLength: 4, MinUpper: 3, MinDigits: 3})
+	fmt.Println(err)
+	//Output: 20
+	//password policy cannot be satisfied: the class minimums need 6 characters, but MaxLength is 4
+}
+
+func countIn(s, chars string) int {
+	var n int
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(chars, s[i]) >= 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func TestPasswordWith(t *testing.T) {
+	g := NewSeededGenerator(7)
+	tests := []struct {
+		name   string
+		policy PasswordPolicy
+		check  func(pw string) bool
+	}{
+		{"default", PasswordPolicy{}, func(pw string) bool {
+			return len(pw) == DefaultPasswordLength
+		}},
+		{"range", PasswordPolicy{MinLength: 8, MaxLength: 10}, func(pw string) bool {
+			return len(pw) >= 8 && len(pw) <= 10
+		}},
+		{"max below default", PasswordPolicy{MaxLength: 8}, func(pw string) bool {
+			return len(pw) == 8
+		}},
+		{"minimums", PasswordPolicy{MinLength: 8, MinLower: 2, MinUpper: 2, MinDigits: 2, MinSymbols: 2}, func(pw string) bool {
+			return countIn(pw, AlphaLower) >= 2 && countIn(pw, AlphaUpper) >= 2 &&
+				countIn(pw, Numbers) >= 2 && countIn(pw, PasswordSym) >= 2
+		}},
+		{"minimums lengthen", PasswordPolicy{MinLength: 4, MinDigits: 6}, func(pw string) bool {
+			return len(pw) == 6 && countIn(pw, Numbers) == 6
+		}},
+		{"custom symbols", PasswordPolicy{MinLength: 30, MinSymbols: 10, Symbols: "$&"}, func(pw string) bool {
+			return countIn(pw, "$&") >= 10 && countIn(pw, PasswordSym) == 0
+		}},
+		{"unicode symbols", PasswordPolicy{MinLength: 12, MinSymbols: 2, Symbols: "€£", Exclude: "£"}, func(pw string) bool {
+			return utf8.ValidString(pw) && strings.Count(pw, "€") >= 2 && utf8.RuneCountInString(pw) == 12
+		}},
+		{"no symbols", PasswordPolicy{MinLength: 50, NoSymbols: true}, func(pw string) bool {
+			return countIn(pw, AlphaNumeric) == 50
+		}},
+		{"exclude", PasswordPolicy{MinLength: 50, Exclude: "aeiouAEIOU!@"}, func(pw string) bool {
+			return countIn(pw, "aeiouAEIOU!@") == 0
+		}},
+		{"ambiguous", PasswordPolicy{MinLength: 100, ExcludeAmbiguous: true}, func(pw string) bool {
+			return countIn(pw, AmbiguousChars) == 0
+		}},
+		{"only digits", PasswordPolicy{MinLength: 10, MinDigits: 10, MaxLength: 10}, func(pw string) bool {
+			return countIn(pw, Numbers) == 10
+		}},
+		{"no repeat", PasswordPolicy{MinLength: 20, MinDigits: 20, NoRepeat: true}, func(pw string) bool {
+			for i := 1; i < len(pw); i++ {
+				if pw[i] == pw[i-1] {
+					return false
+				}
+			}
+			return true
+		}},
+		{"two letters no repeat", PasswordPolicy{MinLength: 30, Exclude: "cdefghijklmnopqrstuvwxyz" + AlphaUpper + Numbers, NoSymbols: true, NoRepeat: true}, func(pw string) bool {
+			return pw == strings.Repeat("ab", 15) || pw == strings.Repeat("ba", 15)
+		}},
+		{"forced minimums no repeat", PasswordPolicy{MinLength: 6, MinDigits: 3, MinUpper: 3, Exclude: "123456789ABCDEFGHIJKLMNOPQRSTUVWXY", NoRepeat: true}, func(pw string) bool {
+			return strings.Count(pw, "0") == 3 && strings.Count(pw, "Z") == 3 && !strings.Contains(pw, "00") && !strings.Contains(pw, "ZZ")
+		}},
+		{"no sequence", PasswordPolicy{MinLength: 30, MinDigits: 30, NoSequence: true}, func(pw string) bool {
+			for i := 2; i < len(pw); i++ {
+				if d := int(pw[i]) - int(pw[i-1]); (d == 1 || d == -1) && int(pw[i-1])-int(pw[i-2]) == d {
+					return false
+				}
+			}
+			return true
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				pw, err := g.PasswordWith(tt.policy)
+				if err != nil || !tt.check(pw) {
+					t.Fatalf("PasswordWith(%+v) = %q, %v", tt.policy, pw, err)
+				}
+			}
+		})
+	}
+
+	if _, err := NewGenerator(&countingReader{limit: 10}).PasswordWith(PasswordPolicy{}); err == nil ||
+		errors.Is(err, ErrUnsatisfiablePolicy) {
+		t.Errorf("PasswordWith() error = %v, want the error of the reader", err)
+	}
+}
+
+func TestPasswordWithUnsatisfiable(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy PasswordPolicy
+		reason string
+	}{
+		{"max below min", PasswordPolicy{MinLength: 10, MaxLength: 5}, "MaxLength 5 is less than MinLength 10"},
+		{"minimums too long", PasswordPolicy{MaxLength: 4, MinUpper: 3, MinDigits: 3}, "need 6 characters"},
+		{"negative length", PasswordPolicy{MinLength: -1}, "length is negative"},
+		{"negative minimum", PasswordPolicy{MinLower: -1}, "lower case letters is negative"},
+		{"symbols excluded", PasswordPolicy{MinSymbols: 1, NoSymbols: true}, "1 symbols are required"},
+		{"digits excluded", PasswordPolicy{MinDigits: 2, Exclude: Numbers}, "2 digits are required"},
+		{"invalid symbols", PasswordPolicy{Symbols: "\xe2\x82"}, "Symbols is not valid UTF-8"},
+		{"everything excluded", PasswordPolicy{Exclude: AlphaNumeric, NoSymbols: true}, "every character is excluded"},
+		{"one character repeated", PasswordPolicy{Exclude: AlphaLower + AlphaUpper + Numbers[1:], NoSymbols: true, NoRepeat: true}, "NoRepeat needs at least 2 characters"},
+		{"repeat impossible", PasswordPolicy{MinLength: 3, MinDigits: 3, Exclude: AlphaLower + AlphaUpper + Numbers[1:], Symbols: "#", NoRepeat: true, MaxLength: 3}, "no password of 3 characters can follow NoRepeat"},
+		{"too many of one class", PasswordPolicy{MinLength: 20, MaxLength: 30, MinLower: 16, MinUpper: 14, Exclude: "bcdefghijklmnopqrstuvwxyzACDEFGHIJKLMNOPQRSTUVWXYZ", NoSymbols: true, NoRepeat: true}, "no password of 30 characters"},
+		{"both rules", PasswordPolicy{MinLength: 5, MaxLength: 6, MinLower: 5, Exclude: "bcdefghijklmnopqrstuvwxyz", NoSymbols: true, NoRepeat: true, NoSequence: true}, "of 5 to 6 characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pw, err := NewSeededGenerator(1).PasswordWith(tt.policy)
+			if !errors.Is(err, ErrUnsatisfiablePolicy) || !strings.Contains(err.Error(), tt.reason) {
+				t.Errorf("PasswordWith() = %q, %v, want error containing %q", pw, err, tt.reason)
+			}
+		})
+	}
+}
@@ -2,8 +2,12 @@ package strings
 
 import (
 	crand "crypto/rand"
-	"math/big"
-	"math/rand"
+	"fmt"
+	"io"
+	"math/bits"
+	"math/rand/v2"
+	"strings"
+	"sync"
 )
 
 const AlphaLower = "abcdefghijklmnopqrstuvwxyz"
@@ -12,22 +16,245 @@ const Numbers = "0123456789"
 const AlphaNumeric = AlphaLower + AlphaUpper + Numbers
 const Token68 = AlphaNumeric + "-._~+/"
 
-// RandomString generates a pseudo random string of the given length using the given characters.
-// The distribution is not perfect, but works for general purposes.
-func RandomString(source string, n int) string {
-	b := make([]byte, n)
-	for i := range b {
-		b[i] = source[rand.Intn(len(source))]
-	}
-	return string(b)
-}
-
 const PasswordLower = "abcdefghijkmnopqrstuvwxyz"
 const PasswordUpper = "ABCDEFGHJKLMNPQRSTUVWXYZ"
 const PasswordNum = "23456789"
 const PasswordSym = "!@#%?+=_"
 const PasswordBytes = PasswordLower + PasswordUpper + PasswordNum + PasswordSym
 
+// Generator makes random strings from a source of randomness.
+//
+// The zero Generator uses the global math/rand/v2 source, and is safe for concurrent use.
+// Use NewSeededGenerator to get reproducible output, like in tests.
+// A Generator must not be copied after it is first used.
+type Generator struct {
+	rand *rand.Rand
+	r    io.Reader
+
+	mu  sync.Mutex // protects buf and pos
+	buf []byte     // random bytes read from r
+	pos int        // the next unused byte in buf
+}
+
+// randomBufferSize is the number of bytes a Generator reads from its reader at a time.
+const randomBufferSize = 512
+
+// NewGenerator returns a Generator that reads its randomness from r, like crypto/rand.Reader.
+// Bytes are read from r in blocks and turned into choices by rejection sampling, so every choice
+// is equally likely as long as the bytes from r are.
+//
+// The Generator is safe for concurrent use. If r returns an error, Fill returns it and the other methods panic.
+func NewGenerator(r io.Reader) *Generator {
+	return &Generator{r: r}
+}
+
+// NewSourceGenerator returns a Generator that gets its randomness from a math/rand/v2 source.
+// It is safe for concurrent use only if src is, and most sources are not.
+func NewSourceGenerator(src rand.Source) *Generator {
+	return &Generator{rand: rand.New(src)}
+}
+
+// NewSeededGenerator returns a Generator that always makes the same strings for the same seed.
+// It is not safe for concurrent use, and must not be used for passwords or other secrets.
+func NewSeededGenerator(seed uint64) *Generator {
+	return NewSourceGenerator(rand.NewPCG(seed, seed))
+}
+
+var defaultGenerator = &Generator{}
+var cryptoGenerator = NewGenerator(crand.Reader)
+
+// IntN returns a random number from 0 up to, but not including, n. It panics if n <= 0.
+func (g *Generator) IntN(n int) int {
+	if n <= 0 {
+		panic("strings: invalid argument to IntN")
+	}
+	v, err := g.intN(n)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// intN is like IntN, but returns the error of the reader instead of panicking.
+func (g *Generator) intN(n int) (int, error) {
+	if g.r == nil {
+		if g.rand == nil {
+			return rand.IntN(n), nil
+		}
+		return g.rand.IntN(n), nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	v, err := g.uint64n(uint64(n))
+	return int(v), err
+}
+
+// Read fills b with random bytes, so that g can be used as an io.Reader.
+// It only returns an error if the reader of g does.
+func (g *Generator) Read(b []byte) (int, error) {
+	if g.r == nil {
+		for i := 0; i < len(b); i += 8 {
+			var v uint64
+			if g.rand == nil {
+				v = rand.Uint64()
+			} else {
+				v = g.rand.Uint64()
+			}
+			for j := i; j < min(i+8, len(b)); j++ {
+				b[j] = byte(v)
+				v >>= 8
+			}
+		}
+		return len(b), nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for i := range b {
+		c, err := g.readByte()
+		if err != nil {
+			return i, err
+		}
+		b[i] = c
+	}
+	return len(b), nil
+}
+
+// Shuffle puts n items in a random order. swap swaps the items at i and j.
+func (g *Generator) Shuffle(n int, swap func(i, j int)) {
+	if g.r == nil {
+		if g.rand == nil {
+			rand.Shuffle(n, swap)
+		} else {
+			g.rand.Shuffle(n, swap)
+		}
+		return
+	}
+	for i := n - 1; i > 0; i-- {
+		swap(i, g.IntN(i+1))
+	}
+}
+
+// Fill fills b with random bytes from source. Each byte of source is equally likely to be picked.
+// Since it picks bytes, source should be ASCII. Use String or an Alphabet for other sources.
+// If the reader of g returns an error, Fill returns it, and b is partly filled. It panics if source is empty
+// and b is not.
+func (g *Generator) Fill(b []byte, source string) error {
+	if len(b) > 0 && source == "" {
+		panic("strings: empty source")
+	}
+	if g.r == nil {
+		for i := range b {
+			b[i] = source[g.IntN(len(source))]
+		}
+		return nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	n := uint64(len(source))
+	for i := range b {
+		j, err := g.uint64n(n)
+		if err != nil {
+			return err
+		}
+		b[i] = source[j]
+	}
+	return nil
+}
+
+// uint64n returns a random number from 0 up to, but not including, n, using bytes from the reader.
+// It reads just enough bytes to cover n, masks off the unneeded bits, and tries again if the result
+// is n or more. Since every accepted number has the same chance, there is no modulo bias, and at least
+// half of the tries are accepted. g.mu must be held.
+func (g *Generator) uint64n(n uint64) (uint64, error) {
+	bitLen := bits.Len64(n - 1)
+	mask := uint64(1)<<bitLen - 1
+	for {
+		var v uint64
+		for i := 0; i < (bitLen+7)/8; i++ {
+			c, err := g.readByte()
+			if err != nil {
+				return 0, err
+			}
+			v = v<<8 | uint64(c)
+		}
+		if v &= mask; v < n {
+			return v, nil
+		}
+	}
+}
+
+// readByte returns the next random byte, reading another block from the reader when the buffer is used up.
+// g.mu must be held.
+func (g *Generator) readByte() (byte, error) {
+	if g.pos >= len(g.buf) {
+		if g.buf == nil {
+			g.buf = make([]byte, randomBufferSize)
+		}
+		n, err := io.ReadAtLeast(g.r, g.buf[:cap(g.buf)], 1)
+		g.buf, g.pos = g.buf[:n], 0
+		if n == 0 {
+			return 0, fmt.Errorf("strings: reading random bytes: %w", err)
+		}
+	}
+	c := g.buf[g.pos]
+	g.pos++
+	return c, nil
+}
+
+// String returns a random string of n characters from source.
+// Each character of source is equally likely to be picked, so a character that is in source twice
+// is picked twice as often. Use an Alphabet to remove repeated characters first.
+// It panics if the reader of g returns an error. Use Fill or AlphabetString to handle the error instead.
+func (g *Generator) String(source string, n int) string {
+	if !isASCII(source) {
+		runes := []rune(source)
+		var b strings.Builder
+		for i := 0; i < n; i++ {
+			b.WriteRune(runes[g.IntN(len(runes))])
+		}
+		return b.String()
+	}
+	b := make([]byte, n)
+	if err := g.Fill(b, source); err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// Token returns a random string of length n made of Token68 characters,
+// which can be used as an HTTP bearer token.
+func (g *Generator) Token(n int) string {
+	return g.String(Token68, n)
+}
+
+// Password returns a random password of length n. See PasswordString for the characters used.
+// n must be at least 4, or an empty string will be returned. Use PasswordWith for other rules.
+// It panics if the reader of g returns an error.
+func (g *Generator) Password(n int) string {
+	if n < 4 {
+		return ""
+	}
+	s, err := g.PasswordWith(PasswordPolicy{
+		MinLength:        n,
+		MinLower:         1,
+		MinUpper:         1,
+		MinDigits:        1,
+		MinSymbols:       1,
+		ExcludeAmbiguous: true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// RandomString generates a pseudo random string of n characters using the characters of source.
+// Each character is equally likely. Use CryptoString for secrets, and a Generator to pick the source
+// of randomness.
+func RandomString(source string, n int) string {
+	return defaultGenerator.String(source, n)
+}
+
 // PasswordString generates a pseudo random password with the given length using characters that are common in passwords.
 // We leave out letters that are easily visually confused.
 //
@@ -36,37 +263,16 @@ const PasswordBytes = PasswordLower + PasswordUpper + PasswordNum + PasswordSym
 //
 // It tries to protect against accidentally creating an easily guessed value by making sure the password has at
 // least one lower-case letter, one upper-case letter, one number, and one symbol.
-// n must be at least 4, or an empty string will be returned.
+// n must be at least 4, or an empty string will be returned. Use Generate for other rules.
 func PasswordString(n int) string {
-	if n < 4 {
-		return ""
-	}
-	b := make([]byte, n)
-	b[0] = PasswordLower[rand.Int()%len(PasswordLower)]
-	b[1] = PasswordUpper[rand.Int()%len(PasswordUpper)]
-	b[2] = PasswordNum[rand.Int()%len(PasswordNum)]
-	b[3] = PasswordSym[rand.Int()%len(PasswordSym)]
-	for i := 4; i < len(b); i++ {
-		b[i] = PasswordBytes[rand.Int()%len(PasswordBytes)]
-	}
-	rand.Shuffle(n, func(i, j int) {
-		temp := b[i]
-		b[i] = b[j]
-		b[j] = temp
-	})
-
-	return string(b)
+	return defaultGenerator.Password(n)
 }
 
-// CryptoString returns a cryptographically secure random string from the given source.
+// CryptoString returns a cryptographically secure random string of n characters from the given source.
 // Use AlphaNumeric, AlphaUpper, AlphaLower, or Numbers as shortcuts for source.
+//
+// Each character of source is equally likely. CryptoString panics if crypto/rand fails, which
+// should never happen. To handle the error instead, use Fill on a Generator made with NewGenerator(crand.Reader).
 func CryptoString(source string, n int) string {
-	ret := make([]byte, n)
-	l := big.NewInt(int64(len(source)))
-	for i := 0; i < n; i++ {
-		num, _ := crand.Int(crand.Reader, l)
-		ret[i] = source[num.Int64()]
-	}
-
-	return string(ret)
+	return cryptoGenerator.String(source, n)
 }
@@ -1,6 +1,9 @@
 package strings
 
 import (
+	crand "crypto/rand"
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -66,3 +69,95 @@ func TestCryptoString(t *testing.T) {
 		t.Errorf(`RandomString("abc", 10) did not return a string of length 10`)
 	}
 }
+
+func ExampleNewSeededGenerator() {
+	g1 := NewSeededGenerator(42)
+	g2 := NewSeededGenerator(42)
+	fmt.Println(g1.String(AlphaNumeric, 12) == g2.String(AlphaNumeric, 12))
+	//Output: true
+}
+
+func TestGenerator(t *testing.T) {
+	g := NewSeededGenerator(1)
+	want := []string{g.String(AlphaLower, 10), g.Token(10), g.Password(10)}
+	g = NewSeededGenerator(1)
+	got := []string{g.String(AlphaLower, 10), g.Token(10), g.Password(10)}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("seeded generator made %q, then %q", want[i], got[i])
+		}
+	}
+	if NewSeededGenerator(2).String(AlphaLower, 20) == NewSeededGenerator(3).String(AlphaLower, 20) {
+		t.Error("different seeds made the same string")
+	}
+
+	for _, g := range []*Generator{{}, NewGenerator(crand.Reader), NewSeededGenerator(4)} {
+		if s := g.Token(30); len(s) != 30 || strings.Trim(s, Token68) != "" {
+			t.Errorf("Token(30) = %q", s)
+		}
+		if s := g.Password(4); len(s) != 4 || !strings.ContainsAny(s, PasswordSym) {
+			t.Errorf("Password(4) = %q", s)
+		}
+	}
+}
+
+// countingReader returns the bytes 0 to 255 over and over, and then fails after limit bytes.
+type countingReader struct {
+	n, limit int
+}
+
+func (r *countingReader) Read(b []byte) (int, error) {
+	for i := range b {
+		if r.n >= r.limit {
+			return i, errors.New("out of randomness")
+		}
+		b[i] = byte(r.n)
+		r.n++
+	}
+	return len(b), nil
+}
+
+func TestGeneratorReader(t *testing.T) {
+	// With every byte value read equally often, every character must be picked equally often.
+	for _, source := range []string{"ab", "xyz", AlphaNumeric, Token68} {
+		g := NewGenerator(&countingReader{limit: 256 * 100})
+		var b []byte
+		for {
+			c := make([]byte, 1)
+			if err := g.Fill(c, source); err != nil {
+				break
+			}
+			b = append(b, c...)
+		}
+		counts := map[byte]int{}
+		for _, c := range b {
+			counts[c]++
+		}
+		for i := range source {
+			if counts[source[i]] != len(b)/len(source) {
+				t.Errorf("source %q: %q picked %d times out of %d", source, source[i], counts[source[i]], len(b))
+			}
+		}
+	}
+
+	g := NewGenerator(&countingReader{limit: 3})
+	b := make([]byte, 10)
+	if err := g.Fill(b, "abcd"); err == nil || !strings.Contains(err.Error(), "out of randomness") {
+		t.Errorf("Fill() error = %v", err)
+	}
+	if string(b[:3]) != "abc" {
+		t.Errorf("Fill() = %q before failing", b[:3])
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("String() did not panic when the reader failed")
+		}
+	}()
+	g.String("abcd", 1)
+}
+
+func BenchmarkCryptoString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		CryptoString(AlphaNumeric, 32)
+	}
+}
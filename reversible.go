@@ -0,0 +1,106 @@
+package strings
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrNotReversible is returned, wrapped with the reason, by the reversible case conversions when given a string
+// that cannot be converted back to itself.
+var ErrNotReversible = errors.New("not reversible")
+
+// SnakeToCamelReversible converts s from snake_case to CamelCase in a way that CamelToSnakeReversible can undo.
+//
+// Unlike SnakeToCamel, it does not use acronyms and every word starts a new upper case letter, so "http_url" becomes
+// "HttpUrl" and "a_b_c" becomes "ABC". For the conversion to be reversible, s must be empty or:
+//   - be made of words joined by single underscores, with no underscore at the start or end.
+//   - have every word start with a lower case letter that has an upper case form, so "address_2_line" is not allowed.
+//   - have only lower case letters, letters without case, numbers and combining marks in the rest of each word.
+//
+// An error wrapping ErrNotReversible is returned if s does not follow these rules.
+func SnakeToCamelReversible(s string) (string, error) {
+	var b strings.Builder
+	wordStart := true
+	for i, r := range s {
+		if r == '_' {
+			if wordStart {
+				return "", notReversible(s, i, "empty word")
+			}
+			wordStart = true
+			continue
+		}
+		if wordStart {
+			if !hasCaseForms(unicode.ToUpper(r), r) {
+				return "", notReversible(s, i, "word does not start with a lower case letter")
+			}
+			b.WriteRune(unicode.ToUpper(r))
+			wordStart = false
+			continue
+		}
+		if c := classOf(r); c == classUpper || c == classSep {
+			return "", notReversible(s, i, fmt.Sprintf("invalid rune %q", r))
+		}
+		b.WriteRune(r)
+	}
+	if wordStart && s != "" {
+		return "", notReversible(s, len(s), "empty word")
+	}
+	return b.String(), nil
+}
+
+// CamelToSnakeReversible converts s from CamelCase to snake_case in a way that SnakeToCamelReversible can undo.
+//
+// Unlike CamelToSnake, every upper case letter starts a new word, so "ABC" becomes "a_b_c" and "HttpUrl" becomes
+// "http_url". For the conversion to be reversible, s must be empty or start with an upper case letter,
+// and contain only letters, numbers and combining marks. Every upper case letter must have a lower case form.
+//
+// An error wrapping ErrNotReversible is returned if s does not follow these rules.
+func CamelToSnakeReversible(s string) (string, error) {
+	var b strings.Builder
+	for i, r := range s {
+		switch classOf(r) {
+		case classUpper:
+			if !hasCaseForms(r, unicode.ToLower(r)) {
+				return "", notReversible(s, i, fmt.Sprintf("upper case letter %q has no lower case form", r))
+			}
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		case classSep:
+			return "", notReversible(s, i, fmt.Sprintf("invalid rune %q", r))
+		default:
+			if i == 0 {
+				return "", notReversible(s, i, "does not start with an upper case letter")
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String(), nil
+}
+
+// CanRoundTrip returns true if s can be converted by SnakeToCamelReversible or CamelToSnakeReversible,
+// and then converted back to s. Call those functions to find out why a string cannot round trip.
+func CanRoundTrip(s string) bool {
+	if _, err := SnakeToCamelReversible(s); err == nil {
+		return true
+	}
+	_, err := CamelToSnakeReversible(s)
+	return err == nil
+}
+
+// hasCaseForms returns true if upper and lower are the upper and lower case forms of the same letter,
+// and each maps to the other.
+func hasCaseForms(upper, lower rune) bool {
+	return upper != lower &&
+		classOf(upper) == classUpper &&
+		classOf(lower) == classLower &&
+		unicode.ToLower(upper) == lower &&
+		unicode.ToUpper(lower) == upper
+}
+
+func notReversible(s string, i int, reason string) error {
+	return fmt.Errorf("%w: %q at byte %d: %s", ErrNotReversible, s, i, reason)
+}
@@ -0,0 +1,125 @@
+package strings
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleSnakeToCamelReversible() {
+	c, _ := SnakeToCamelReversible("http_url")
+	s, _ := CamelToSnakeReversible(c)
+	fmt.Println(c, s)
+	_, err := SnakeToCamelReversible("address_2_line")
+	fmt.Println(err)
+	//Output: HttpUrl http_url
+	//not reversible: "address_2_line" at byte 8: word does not start with a lower case letter
+}
+
+func TestReversible_RoundTrip(t *testing.T) {
+	snakes := []string{
+		"",
+		"a",
+		"a_b_c",
+		"http_url",
+		"user_id",
+		"address2_line",
+		"with_numbers123",
+		"élan_vital",
+		"naïve_café",
+		"straße_name",
+		"ο_δρόμος",
+	}
+	for _, s := range snakes {
+		t.Run(s, func(t *testing.T) {
+			if !CanRoundTrip(s) {
+				t.Errorf("CanRoundTrip(%q) = false", s)
+			}
+			c, err := SnakeToCamelReversible(s)
+			if err != nil {
+				t.Fatalf("SnakeToCamelReversible(%q) error: %v", s, err)
+			}
+			back, err := CamelToSnakeReversible(c)
+			if err != nil {
+				t.Fatalf("CamelToSnakeReversible(%q) error: %v", c, err)
+			}
+			if back != s {
+				t.Errorf("round trip of %q gave %q via %q", s, back, c)
+			}
+		})
+	}
+}
+
+func TestSnakeToCamelReversible(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"a_b_c", "ABC", false},
+		{"http_url", "HttpUrl", false},
+		{"address2_line", "Address2Line", false},
+		{"address_2_line", "", true},
+		{"2fa", "", true},
+		{"_user", "", true},
+		{"user_", "", true},
+		{"user__id", "", true},
+		{"userID", "", true},
+		{"user-id", "", true},
+		{"_", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := SnakeToCamelReversible(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SnakeToCamelReversible(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrNotReversible) {
+				t.Errorf("SnakeToCamelReversible(%q) error %v is not ErrNotReversible", tt.s, err)
+			}
+			if got != tt.want {
+				t.Errorf("SnakeToCamelReversible(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCamelToSnakeReversible(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"ABC", "a_b_c", false},
+		{"UserID", "user_i_d", false},
+		{"Address2Line", "address2_line", false},
+		{"userId", "", true},
+		{"2Fa", "", true},
+		{"User_Id", "", true},
+		{"ǅemal", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := CamelToSnakeReversible(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CamelToSnakeReversible(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CamelToSnakeReversible(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+			if err == nil {
+				if back, _ := SnakeToCamelReversible(got); back != tt.s {
+					t.Errorf("round trip of %q gave %q", tt.s, back)
+				}
+			}
+		})
+	}
+}
+
+func TestCanRoundTrip(t *testing.T) {
+	for _, s := range []string{"address_2_line", "user__id", "user_ID", "userId", "a b", "日本語"} {
+		if CanRoundTrip(s) {
+			t.Errorf("CanRoundTrip(%q) = true", s)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package strings
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxRoman is the largest number that can be written as a standard Roman numeral.
+const MaxRoman = 3999
+
+// RomanOptions control how Roman numerals are written by ToRomanWith and read by FromRomanWith.
+type RomanOptions struct {
+	// Lower writes numerals in lower case, like "xiv". When reading, numerals may be all upper or all lower case.
+	Lower bool
+	// Unicode writes numerals with the Roman numeral characters from U+2160 to U+217F, like "ⅩⅣ".
+	// When reading, those characters are accepted, including the ones for more than one letter like "Ⅻ".
+	Unicode bool
+}
+
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// romanRunes are the letters of the upper case Roman numeral characters from U+2160 to U+216F.
+// The lower case characters from U+2170 to U+217F are in the same order.
+var romanRunes = []string{
+	"I", "II", "III", "IV", "V", "VI", "VII", "VIII", "IX", "X", "XI", "XII", "L", "C", "D", "M",
+}
+
+// ToRoman writes n as an upper case Roman numeral, like "XIV" for 14 or "MCMXCIV" for 1994.
+//
+// If n is less than 1 or greater than MaxRoman, the error wraps ErrRange.
+func ToRoman(n int) (string, error) {
+	return ToRomanWith(n, RomanOptions{})
+}
+
+// ToRomanWith is like ToRoman, but the options select lower case or Unicode Roman numeral characters.
+func ToRomanWith(n int, opts RomanOptions) (string, error) {
+	if n < 1 || n > MaxRoman {
+		return "", fmt.Errorf("converting %d to a Roman numeral: %w", n, ErrRange)
+	}
+	var b strings.Builder
+	for _, rn := range romanNumerals {
+		for ; n >= rn.value; n -= rn.value {
+			b.WriteString(rn.symbol)
+		}
+	}
+	s := b.String()
+	if opts.Unicode {
+		s = strings.Map(func(r rune) rune {
+			for i, l := range romanRunes {
+				if l == string(r) {
+					return 0x2160 + rune(i)
+				}
+			}
+			return r
+		}, s)
+		if opts.Lower {
+			s = strings.Map(func(r rune) rune { return r + 0x10 }, s)
+		}
+	} else if opts.Lower {
+		s = strings.ToLower(s)
+	}
+	return s, nil
+}
+
+// FromRoman parses an upper case Roman numeral, like "XIV" or "MCMXCIV".
+//
+// The numeral must be in the standard form ToRoman writes, so "IIII", "IC" and "VX" are not accepted.
+// If s is not a Roman numeral, the error wraps ErrSyntax.
+func FromRoman(s string) (int, error) {
+	return FromRomanWith(s, RomanOptions{})
+}
+
+// FromRomanWith is like FromRoman, but the options also accept lower case numerals and Unicode Roman numeral
+// characters. A numeral may mix Unicode characters and letters, as long as together they spell a standard numeral.
+func FromRomanWith(s string, opts RomanOptions) (int, error) {
+	t := s
+	if opts.Unicode {
+		var b strings.Builder
+		for _, r := range t {
+			switch {
+			case r >= 0x2160 && r <= 0x216f:
+				b.WriteString(romanRunes[r-0x2160])
+			case r >= 0x2170 && r <= 0x217f:
+				b.WriteString(strings.ToLower(romanRunes[r-0x2170]))
+			default:
+				b.WriteRune(r)
+			}
+		}
+		t = b.String()
+	}
+	if opts.Lower && t == strings.ToLower(t) {
+		t = strings.ToUpper(t)
+	}
+	n := 0
+	rest := t
+	for _, rn := range romanNumerals {
+		for strings.HasPrefix(rest, rn.symbol) {
+			n += rn.value
+			rest = rest[len(rn.symbol):]
+		}
+	}
+	// A numeral that is not standard either has letters left over or is written differently by ToRoman.
+	if rest != "" || n == 0 {
+		return 0, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+	}
+	if canonical, err := ToRoman(n); err != nil || canonical != t {
+		return 0, fmt.Errorf("parsing %q: %w", s, ErrSyntax)
+	}
+	return n, nil
+}
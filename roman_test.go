@@ -0,0 +1,109 @@
+package strings
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleToRoman() {
+	s, _ := ToRoman(1994)
+	fmt.Println(s)
+	n, _ := FromRoman("XIV")
+	fmt.Println(n)
+	//Output: MCMXCIV
+	//14
+}
+
+func TestToRoman(t *testing.T) {
+	tests := []struct {
+		n    int
+		opts RomanOptions
+		want string
+	}{
+		{1, RomanOptions{}, "I"},
+		{4, RomanOptions{}, "IV"},
+		{9, RomanOptions{}, "IX"},
+		{14, RomanOptions{}, "XIV"},
+		{40, RomanOptions{}, "XL"},
+		{90, RomanOptions{}, "XC"},
+		{400, RomanOptions{}, "CD"},
+		{1994, RomanOptions{}, "MCMXCIV"},
+		{2024, RomanOptions{}, "MMXXIV"},
+		{3999, RomanOptions{}, "MMMCMXCIX"},
+		{14, RomanOptions{Lower: true}, "xiv"},
+		{14, RomanOptions{Unicode: true}, "ⅩⅠⅤ"},
+		{14, RomanOptions{Unicode: true, Lower: true}, "ⅹⅰⅴ"},
+		{1666, RomanOptions{Unicode: true}, "ⅯⅮⅭⅬⅩⅤⅠ"},
+	}
+	for _, tt := range tests {
+		if got, err := ToRomanWith(tt.n, tt.opts); err != nil || got != tt.want {
+			t.Errorf("ToRomanWith(%d, %+v) = %q, %v, want %q", tt.n, tt.opts, got, err, tt.want)
+		}
+	}
+	for _, n := range []int{0, -1, 4000} {
+		if _, err := ToRoman(n); !errors.Is(err, ErrRange) {
+			t.Errorf("ToRoman(%d) error = %v, want ErrRange", n, err)
+		}
+	}
+}
+
+func TestFromRoman(t *testing.T) {
+	tests := []struct {
+		s       string
+		opts    RomanOptions
+		want    int
+		wantErr bool
+	}{
+		{"I", RomanOptions{}, 1, false},
+		{"XIV", RomanOptions{}, 14, false},
+		{"MCMXCIV", RomanOptions{}, 1994, false},
+		{"MMMCMXCIX", RomanOptions{}, 3999, false},
+		{"", RomanOptions{}, 0, true},
+		{"IIII", RomanOptions{}, 0, true},
+		{"VV", RomanOptions{}, 0, true},
+		{"IC", RomanOptions{}, 0, true},
+		{"VX", RomanOptions{}, 0, true},
+		{"IL", RomanOptions{}, 0, true},
+		{"XM", RomanOptions{}, 0, true},
+		{"MMMM", RomanOptions{}, 0, true},
+		{"XIIV", RomanOptions{}, 0, true},
+		{"XIVA", RomanOptions{}, 0, true},
+		{" XIV", RomanOptions{}, 0, true},
+		{"xiv", RomanOptions{}, 0, true},
+		{"xiv", RomanOptions{Lower: true}, 14, false},
+		{"XIV", RomanOptions{Lower: true}, 14, false},
+		{"Xiv", RomanOptions{Lower: true}, 0, true},
+		{"ⅩⅠⅤ", RomanOptions{}, 0, true},
+		{"ⅩⅠⅤ", RomanOptions{Unicode: true}, 14, false},
+		{"Ⅻ", RomanOptions{Unicode: true}, 12, false},
+		{"ⅩⅡ", RomanOptions{Unicode: true}, 12, false},
+		{"ⅠⅠⅠⅠ", RomanOptions{Unicode: true}, 0, true},
+		{"ⅹⅳ", RomanOptions{Unicode: true}, 0, true},
+		{"ⅹⅳ", RomanOptions{Unicode: true, Lower: true}, 14, false},
+	}
+	for _, tt := range tests {
+		got, err := FromRomanWith(tt.s, tt.opts)
+		if tt.wantErr {
+			if !errors.Is(err, ErrSyntax) {
+				t.Errorf("FromRomanWith(%q, %+v) = %d, %v, want ErrSyntax", tt.s, tt.opts, got, err)
+			}
+		} else if err != nil || got != tt.want {
+			t.Errorf("FromRomanWith(%q, %+v) = %d, %v, want %d", tt.s, tt.opts, got, err, tt.want)
+		}
+	}
+}
+
+func TestRomanRoundTrip(t *testing.T) {
+	for _, opts := range []RomanOptions{{}, {Lower: true}, {Unicode: true}, {Unicode: true, Lower: true}} {
+		for n := 1; n <= MaxRoman; n++ {
+			s, err := ToRomanWith(n, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, err := FromRomanWith(s, opts); err != nil || got != n {
+				t.Fatalf("FromRomanWith(%q, %+v) = %d, %v, want %d", s, opts, got, err, n)
+			}
+		}
+	}
+}
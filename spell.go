@@ -0,0 +1,170 @@
+package strings
+
+import (
+	"golang.org/x/text/language"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// NumberSpeller writes numbers as words in a particular language.
+// Use RegisterSpeller to add one for a language, and SpellerFor to get one.
+type NumberSpeller interface {
+	// Ordinal writes n as an ordinal in digits, like "1st" or "22nd" in English.
+	Ordinal(n int64) string
+	// SpellOut writes n in words, like "one hundred twenty-three" in English.
+	SpellOut(n int64) string
+	// SpellOrdinal writes n as an ordinal in words, like "twenty-first" in English.
+	SpellOrdinal(n int64) string
+}
+
+var spellers = struct {
+	sync.RWMutex
+	m map[language.Base]NumberSpeller
+}{m: map[language.Base]NumberSpeller{}}
+
+func init() {
+	RegisterSpeller(language.English, EnglishSpeller{})
+}
+
+// RegisterSpeller makes s the NumberSpeller for the base language of tag, replacing any that was there.
+// An English speller is registered by default.
+func RegisterSpeller(tag language.Tag, s NumberSpeller) {
+	base, _ := tag.Base()
+	spellers.Lock()
+	defer spellers.Unlock()
+	spellers.m[base] = s
+}
+
+// SpellerFor returns the NumberSpeller registered for the base language of tag, so that a speller registered
+// for English is used for British English. If there is none, the English speller is returned.
+func SpellerFor(tag language.Tag) NumberSpeller {
+	base, _ := tag.Base()
+	english, _ := language.English.Base()
+	spellers.RLock()
+	defer spellers.RUnlock()
+	if s, ok := spellers.m[base]; ok {
+		return s
+	}
+	return spellers.m[english]
+}
+
+// Ordinal writes n as an English ordinal in digits, like "1st", "22nd" or "113th".
+// Use SpellerFor for other languages.
+func Ordinal(n int64) string {
+	return SpellerFor(language.English).Ordinal(n)
+}
+
+// SpellOut writes n in English words, like "one hundred twenty-three".
+// Use SpellerFor for other languages.
+func SpellOut(n int64) string {
+	return SpellerFor(language.English).SpellOut(n)
+}
+
+// SpellOrdinal writes n as an English ordinal in words, like "twenty-first".
+// Use SpellerFor for other languages.
+func SpellOrdinal(n int64) string {
+	return SpellerFor(language.English).SpellOrdinal(n)
+}
+
+// EnglishSpeller is the NumberSpeller for English. It uses American style, without "and" after hundreds,
+// so 123 is "one hundred twenty-three", and the short scale, so 10^9 is "one billion".
+type EnglishSpeller struct{}
+
+var englishOnes = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+	"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+}
+
+var englishTens = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+
+var englishScales = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+
+// englishIrregularOrdinals are the words whose ordinal is not made by adding "th".
+var englishIrregularOrdinals = map[string]string{
+	"one": "first", "two": "second", "three": "third", "five": "fifth",
+	"eight": "eighth", "nine": "ninth", "twelve": "twelfth",
+}
+
+// Ordinal writes n as an ordinal in digits, like "1st", "22nd" or "113th".
+func (EnglishSpeller) Ordinal(n int64) string {
+	s := strconv.FormatInt(n, 10)
+	u := magnitude(n)
+	if u%100 >= 11 && u%100 <= 13 {
+		return s + "th"
+	}
+	switch u % 10 {
+	case 1:
+		return s + "st"
+	case 2:
+		return s + "nd"
+	case 3:
+		return s + "rd"
+	}
+	return s + "th"
+}
+
+// SpellOut writes n in words, like "one hundred twenty-three" or "minus five".
+func (EnglishSpeller) SpellOut(n int64) string {
+	u := magnitude(n)
+	if u == 0 {
+		return englishOnes[0]
+	}
+	var groups []string
+	for scale := 0; u > 0; scale++ {
+		if g := u % 1000; g > 0 {
+			words := englishHundreds(int(g))
+			if englishScales[scale] != "" {
+				words += " " + englishScales[scale]
+			}
+			groups = append([]string{words}, groups...)
+		}
+		u /= 1000
+	}
+	s := strings.Join(groups, " ")
+	if n < 0 {
+		s = "minus " + s
+	}
+	return s
+}
+
+// SpellOrdinal writes n as an ordinal in words, like "twenty-first" or "one hundredth".
+func (e EnglishSpeller) SpellOrdinal(n int64) string {
+	s := e.SpellOut(n)
+	i := strings.LastIndexAny(s, " -") + 1
+	last := s[i:]
+	if o, ok := englishIrregularOrdinals[last]; ok {
+		last = o
+	} else if strings.HasSuffix(last, "y") {
+		last = strings.TrimSuffix(last, "y") + "ieth"
+	} else {
+		last += "th"
+	}
+	return s[:i] + last
+}
+
+// englishHundreds spells out a number from 1 to 999.
+func englishHundreds(n int) string {
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, englishOnes[n/100]+" hundred")
+		n %= 100
+	}
+	switch {
+	case n >= 20 && n%10 != 0:
+		parts = append(parts, englishTens[n/10]+"-"+englishOnes[n%10])
+	case n >= 20:
+		parts = append(parts, englishTens[n/10])
+	case n > 0:
+		parts = append(parts, englishOnes[n])
+	}
+	return strings.Join(parts, " ")
+}
+
+// magnitude returns the absolute value of n, which fits in a uint64 even for the smallest int64.
+func magnitude(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
@@ -0,0 +1,128 @@
+package strings
+
+import (
+	"fmt"
+	"golang.org/x/text/language"
+	"math"
+	"testing"
+)
+
+func ExampleSpellOut() {
+	fmt.Println(Ordinal(22))
+	fmt.Println(SpellOut(123))
+	fmt.Println(SpellOrdinal(21))
+	//Output: 22nd
+	//one hundred twenty-three
+	//twenty-first
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0th"},
+		{1, "1st"},
+		{2, "2nd"},
+		{3, "3rd"},
+		{4, "4th"},
+		{11, "11th"},
+		{12, "12th"},
+		{13, "13th"},
+		{21, "21st"},
+		{22, "22nd"},
+		{101, "101st"},
+		{111, "111th"},
+		{113, "113th"},
+		{1002, "1002nd"},
+		{-1, "-1st"},
+		{-12, "-12th"},
+		{math.MinInt64, "-9223372036854775808th"},
+	}
+	for _, tt := range tests {
+		if got := Ordinal(tt.n); got != tt.want {
+			t.Errorf("Ordinal(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestSpellOut(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "zero"},
+		{7, "seven"},
+		{13, "thirteen"},
+		{20, "twenty"},
+		{42, "forty-two"},
+		{100, "one hundred"},
+		{123, "one hundred twenty-three"},
+		{1000, "one thousand"},
+		{1001, "one thousand one"},
+		{2_000_010, "two million ten"},
+		{1_234_567, "one million two hundred thirty-four thousand five hundred sixty-seven"},
+		{-5, "minus five"},
+		{math.MaxInt64, "nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion " +
+			"thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred seven"},
+		{math.MinInt64, "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion " +
+			"thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight"},
+	}
+	for _, tt := range tests {
+		if got := SpellOut(tt.n); got != tt.want {
+			t.Errorf("SpellOut(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestSpellOrdinal(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "zeroth"},
+		{1, "first"},
+		{2, "second"},
+		{3, "third"},
+		{4, "fourth"},
+		{5, "fifth"},
+		{8, "eighth"},
+		{9, "ninth"},
+		{12, "twelfth"},
+		{13, "thirteenth"},
+		{20, "twentieth"},
+		{21, "twenty-first"},
+		{99, "ninety-ninth"},
+		{100, "one hundredth"},
+		{103, "one hundred third"},
+		{1_000_000, "one millionth"},
+		{-2, "minus second"},
+	}
+	for _, tt := range tests {
+		if got := SpellOrdinal(tt.n); got != tt.want {
+			t.Errorf("SpellOrdinal(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+type testSpeller struct{}
+
+func (testSpeller) Ordinal(n int64) string      { return fmt.Sprintf("%d.", n) }
+func (testSpeller) SpellOut(n int64) string     { return "zahl" }
+func (testSpeller) SpellOrdinal(n int64) string { return "zahlte" }
+
+func TestSpellerFor(t *testing.T) {
+	if _, ok := SpellerFor(language.BritishEnglish).(EnglishSpeller); !ok {
+		t.Error("SpellerFor(en-GB) is not the English speller")
+	}
+	if _, ok := SpellerFor(language.Japanese).(EnglishSpeller); !ok {
+		t.Error("SpellerFor(ja) did not fall back to the English speller")
+	}
+	RegisterSpeller(language.German, testSpeller{})
+	if got := SpellerFor(language.MustParse("de-AT")).Ordinal(3); got != "3." {
+		t.Errorf("SpellerFor(de-AT).Ordinal(3) = %q", got)
+	}
+	if got := Ordinal(3); got != "3rd" {
+		t.Errorf("Ordinal(3) after registering German = %q", got)
+	}
+}
@@ -0,0 +1,930 @@
+package strings
+
+import (
+	_ "embed"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// commonPasswords are common passwords, most common first.
+//
+//go:embed common_passwords.txt
+var commonPasswords string
+
+// commonNames are common first names and surnames, most common first.
+//
+//go:embed common_names.txt
+var commonNames string
+
+// Entropy returns the entropy in bits of a string of n characters picked at random from source, the way
+// RandomString and CryptoString pick them. It is the number of bits of randomness an attacker who knows
+// source has to guess. A character that is in source more than once is more likely to be picked,
+// which lowers the entropy. Use Alphabet.Entropy for an Alphabet.
+//
+//	Entropy(AlphaNumeric, 20) // about 119 bits
+func Entropy(source string, n int) float64 {
+	counts := make(map[rune]int)
+	var total int
+	for _, r := range source {
+		counts[r]++
+		total++
+	}
+	var h float64
+	for _, c := range counts {
+		p := float64(c) / float64(total)
+		h -= p * math.Log2(p)
+	}
+	return h * float64(n)
+}
+
+// The patterns of a StrengthMatch.
+const (
+	PatternDictionary = "dictionary"
+	PatternSpatial    = "spatial"
+	PatternRepeat     = "repeat"
+	PatternSequence   = "sequence"
+	PatternYear       = "year"
+	PatternDate       = "date"
+	PatternBruteforce = "bruteforce"
+)
+
+// Strength is an estimate of how hard a password is to guess, made by EstimateStrength.
+type Strength struct {
+	// Score rates the password from 0 to 4:
+	//   - 0 is too guessable, under 10^3 guesses.
+	//   - 1 is very guessable, and protects only against throttled online attacks. Under 10^6 guesses.
+	//   - 2 is somewhat guessable, and protects against unthrottled online attacks. Under 10^8 guesses.
+	//   - 3 is safely unguessable, and moderately protects against offline attacks on slow hashes. Under 10^10 guesses.
+	//   - 4 is very unguessable, and strongly protects against offline attacks on slow hashes.
+	Score int
+	// Guesses is the estimated number of guesses needed to find the password.
+	Guesses float64
+	// CrackTimeOnline is how long guessing the password takes at 10 guesses a second, like an online attack
+	// on a service that does not limit attempts.
+	CrackTimeOnline time.Duration
+	// CrackTimeOffline is how long guessing the password takes at 10,000 guesses a second, like an offline attack
+	// on passwords hashed with a slow hash such as bcrypt. Times too long for a time.Duration are the largest one.
+	CrackTimeOffline time.Duration
+	// Warning explains what makes the password weak. It is empty for strong passwords.
+	Warning string
+	// Suggestions are ways to make the password stronger. It is empty for strong passwords.
+	Suggestions []string
+	// Matches are the parts of the password that the estimate is made from, in order.
+	Matches []StrengthMatch
+}
+
+// StrengthMatch is a part of a password that can be guessed as a unit, like a dictionary word or keyboard pattern.
+type StrengthMatch struct {
+	// Pattern is the kind of guessable part, like PatternDictionary.
+	Pattern string
+	// Token is the part of the password.
+	Token string
+	// Guesses is the estimated number of guesses needed to find Token.
+	Guesses float64
+}
+
+// StrengthOptions control EstimateStrengthWith.
+type StrengthOptions struct {
+	// UserInputs are words an attacker is likely to try, like the user's name and email, or the name of the site.
+	// Passwords made from them are rated as very easy to guess.
+	UserInputs []string
+}
+
+// EstimateStrength estimates how hard a password chosen by a person is to guess, using the approach of zxcvbn.
+//
+// The password is broken into the parts an attacker would guess: common passwords, names and words, including
+// reversed, capitalized and l33t spellings like "p@ssw0rd", keyboard patterns like "qwerty" and "zxcvfr",
+// repeats like "aaa" or "abcabc", sequences like "abc" or "7531", and years and dates. The number of guesses
+// to find the password is estimated from the easiest way to put it together from these parts, with the rest
+// guessed character by character. Only the first 100 characters are looked at, and the rest are ignored.
+//
+// Unlike counting character types, this rates "Password1!" as weak and "correct horse battery staple" as strong.
+func EstimateStrength(pw string) Strength {
+	return EstimateStrengthWith(pw, StrengthOptions{})
+}
+
+// EstimateStrengthWith is like EstimateStrength, but also treats the user inputs in opts as easy to guess.
+func EstimateStrengthWith(pw string, opts StrengthOptions) Strength {
+	e := strengthEstimator{dicts: strengthDictionaries()}
+	if len(opts.UserInputs) > 0 {
+		user := make(map[string]int)
+		for i, w := range opts.UserInputs {
+			w = strings.ToLower(w)
+			if _, ok := user[w]; !ok && w != "" {
+				user[w] = i + 1
+			}
+		}
+		e.dicts = append([]rankedDictionary{{"user", user}}, e.dicts...)
+	}
+	e.year = time.Now().Year()
+
+	// Characters past the limit are ignored, so that they can never make a password look stronger
+	// than it is, like a long run of one character would.
+	runes := []rune(pw)
+	if len(runes) > strengthMaxLength {
+		runes = runes[:strengthMaxLength]
+	}
+	guesses, seq := e.mostGuessable(runes)
+
+	s := Strength{
+		Guesses:          guesses,
+		Score:            strengthScore(guesses),
+		CrackTimeOnline:  crackTime(guesses, 10),
+		CrackTimeOffline: crackTime(guesses, 1e4),
+	}
+	for _, m := range seq {
+		s.Matches = append(s.Matches, StrengthMatch{Pattern: m.pattern, Token: m.token, Guesses: m.guesses})
+	}
+	s.Warning, s.Suggestions = strengthFeedback(s.Score, seq)
+	return s
+}
+
+// strengthMaxLength is the number of runes of a password that EstimateStrength looks for patterns in.
+const strengthMaxLength = 100
+
+// minGuessesBeforeGrowingSequence is the penalty for each part a password is broken into. Without it,
+// the estimate would prefer breaking a password into many tiny parts.
+const minGuessesBeforeGrowingSequence = 10000
+
+func strengthScore(guesses float64) int {
+	const delta = 5
+	switch {
+	case guesses < 1e3+delta:
+		return 0
+	case guesses < 1e6+delta:
+		return 1
+	case guesses < 1e8+delta:
+		return 2
+	case guesses < 1e10+delta:
+		return 3
+	}
+	return 4
+}
+
+// crackTime returns how long it takes to make the number of guesses at the given rate per second.
+func crackTime(guesses, perSecond float64) time.Duration {
+	d := guesses / perSecond * float64(time.Second)
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+// rankedDictionary maps the words of a dictionary to their rank, starting at 1 for the most common word.
+type rankedDictionary struct {
+	name  string
+	ranks map[string]int
+}
+
+// strengthDictionaries returns the built-in dictionaries. The words of EFFWordlist are not ranked,
+// so each is given the rank of the middle of the list, which is how many guesses it takes on average.
+var strengthDictionaries = sync.OnceValue(func() []rankedDictionary {
+	ranked := func(list string) map[string]int {
+		m := make(map[string]int)
+		for i, w := range strings.Fields(list) {
+			m[w] = i + 1
+		}
+		return m
+	}
+	english := make(map[string]int)
+	eff := EFFWordlist()
+	for _, w := range eff {
+		english[w] = len(eff) / 2
+	}
+	return []rankedDictionary{
+		{"passwords", ranked(commonPasswords)},
+		{"names", ranked(commonNames)},
+		{"english", english},
+	}
+})
+
+// strengthEstimator finds the patterns in passwords.
+type strengthEstimator struct {
+	dicts []rankedDictionary
+	year  int // the year that recent years are counted from
+}
+
+// pwMatch is a pattern found in a password, from rune i to rune j inclusive.
+type pwMatch struct {
+	pattern string
+	i, j    int
+	token   string
+	guesses float64
+
+	dict     string // the dictionary of a dictionary match
+	rank     int
+	reversed bool
+	l33t     bool
+	turns    int // the number of turns in a spatial match
+	baseLen  int // the length of the repeated part of a repeat match
+}
+
+// mostGuessable returns the number of guesses needed for runes, and the sequence of matches that
+// takes the fewest guesses, with the parts that match no pattern filled in by bruteforce matches.
+func (e *strengthEstimator) mostGuessable(runes []rune) (float64, []*pwMatch) {
+	n := len(runes)
+	if n == 0 {
+		return 1, nil
+	}
+	byEnd := make([][]*pwMatch, n)
+	for _, m := range e.omnimatch(runes) {
+		byEnd[m.j] = append(byEnd[m.j], m)
+	}
+
+	// optimal[k] holds, for each number of matches l, the best sequence of l matches that covers runes[:k+1]:
+	// its last match, the product of the guesses of its matches, and its total guesses.
+	type best struct {
+		m  map[int]*pwMatch
+		pi map[int]float64
+		g  map[int]float64
+	}
+	optimal := make([]best, n)
+	for k := range optimal {
+		optimal[k] = best{map[int]*pwMatch{}, map[int]float64{}, map[int]float64{}}
+	}
+	minGuesses := func(m *pwMatch) float64 {
+		switch {
+		case m.j-m.i+1 == n:
+			return m.guesses
+		case m.i == m.j:
+			return math.Max(m.guesses, 10)
+		}
+		return math.Max(m.guesses, 50)
+	}
+	update := func(m *pwMatch, l int) {
+		pi := minGuesses(m)
+		if l > 1 {
+			pi *= optimal[m.i-1].pi[l-1]
+		}
+		g := factorial(l)*pi + math.Pow(minGuessesBeforeGrowingSequence, float64(l-1))
+		for cl, cg := range optimal[m.j].g {
+			if cl <= l && cg <= g {
+				return
+			}
+		}
+		optimal[m.j].m[l], optimal[m.j].pi[l], optimal[m.j].g[l] = m, pi, g
+	}
+	for k := 0; k < n; k++ {
+		for _, m := range byEnd[k] {
+			if m.i == 0 {
+				update(m, 1)
+				continue
+			}
+			for l := range optimal[m.i-1].m {
+				update(m, l+1)
+			}
+		}
+		update(bruteforceMatch(runes, 0, k), 1)
+		for i := 1; i <= k; i++ {
+			bf := bruteforceMatch(runes, i, k)
+			for l, last := range optimal[i-1].m {
+				if last.pattern != PatternBruteforce {
+					update(bf, l+1)
+				}
+			}
+		}
+	}
+
+	bestL, guesses := 0, math.Inf(1)
+	for l, g := range optimal[n-1].g {
+		if g < guesses || g == guesses && l < bestL {
+			bestL, guesses = l, g
+		}
+	}
+	seq := make([]*pwMatch, bestL)
+	for k, l := n-1, bestL; k >= 0; l-- {
+		m := optimal[k].m[l]
+		seq[l-1] = m
+		k = m.i - 1
+	}
+	return guesses, seq
+}
+
+// omnimatch returns all the patterns found in runes.
+func (e *strengthEstimator) omnimatch(runes []rune) []*pwMatch {
+	var matches []*pwMatch
+	matches = append(matches, e.dictionaryMatches(runes)...)
+	matches = append(matches, e.reversedMatches(runes)...)
+	matches = append(matches, e.l33tMatches(runes)...)
+	matches = append(matches, spatialMatches(runes)...)
+	matches = append(matches, e.repeatMatches(runes)...)
+	matches = append(matches, sequenceMatches(runes)...)
+	matches = append(matches, e.yearMatches(runes)...)
+	matches = append(matches, e.dateMatches(runes)...)
+	return matches
+}
+
+func bruteforceMatch(runes []rune, i, j int) *pwMatch {
+	l := j - i + 1
+	g := math.Pow(10, float64(l))
+	if l == 1 {
+		g = math.Max(g, 11)
+	} else {
+		g = math.Max(g, 51)
+	}
+	return &pwMatch{pattern: PatternBruteforce, i: i, j: j, token: string(runes[i : j+1]), guesses: g}
+}
+
+// dictionaryMatches finds the words of the dictionaries in runes, ignoring case.
+func (e *strengthEstimator) dictionaryMatches(runes []rune) []*pwMatch {
+	return e.lookupWords(runes, []rune(strings.ToLower(string(runes))))
+}
+
+// lookupWords finds the words of the dictionaries in lower, which is runes in lower case, possibly with
+// other changes. The matches have the tokens from runes.
+func (e *strengthEstimator) lookupWords(runes, lower []rune) []*pwMatch {
+	var matches []*pwMatch
+	if len(lower) != len(runes) {
+		// lower casing changed the length, so the positions do not line up
+		return nil
+	}
+	for i := range lower {
+		for j := i; j < len(lower); j++ {
+			word := string(lower[i : j+1])
+			for _, d := range e.dicts {
+				if rank, ok := d.ranks[word]; ok {
+					token := string(runes[i : j+1])
+					matches = append(matches, &pwMatch{
+						pattern: PatternDictionary, i: i, j: j, token: token, dict: d.name, rank: rank,
+						guesses: float64(rank) * uppercaseVariations(token),
+					})
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// reversedMatches finds the words of the dictionaries written backwards.
+func (e *strengthEstimator) reversedMatches(runes []rune) []*pwMatch {
+	n := len(runes)
+	reversed := make([]rune, n)
+	for i, r := range runes {
+		reversed[n-1-i] = r
+	}
+	matches := e.dictionaryMatches(reversed)
+	for _, m := range matches {
+		m.i, m.j = n-1-m.j, n-1-m.i
+		m.token = string(runes[m.i : m.j+1])
+		m.reversed = true
+		m.guesses *= 2
+	}
+	return matches
+}
+
+// l33tTable maps the characters used in l33t spellings to the letters they replace.
+var l33tTable = map[rune][]rune{
+	'4': {'a'}, '@': {'a'}, '8': {'b'}, '(': {'c'}, '{': {'c'}, '[': {'c'}, '<': {'c'},
+	'3': {'e'}, '6': {'g'}, '9': {'g'}, '1': {'i', 'l'}, '!': {'i'}, '|': {'i', 'l'},
+	'7': {'l', 't'}, '0': {'o'}, '$': {'s'}, '5': {'s'}, '+': {'t'}, '%': {'x'}, '2': {'z'},
+}
+
+// maxL33tSubs limits how many ways of undoing l33t substitutions are tried.
+const maxL33tSubs = 64
+
+// l33tMatches finds the words of the dictionaries spelled with l33t substitutions, like "p@ssw0rd".
+func (e *strengthEstimator) l33tMatches(runes []rune) []*pwMatch {
+	var chars []rune
+	for r := range l33tTable {
+		if containsRune(runes, r) {
+			chars = append(chars, r)
+		}
+	}
+	if len(chars) == 0 {
+		return nil
+	}
+	sort.Slice(chars, func(a, b int) bool { return chars[a] < chars[b] })
+	subs := []map[rune]rune{{}}
+	for _, c := range chars {
+		var next []map[rune]rune
+		for _, sub := range subs {
+			for _, letter := range l33tTable[c] {
+				s := make(map[rune]rune, len(sub)+1)
+				for k, v := range sub {
+					s[k] = v
+				}
+				s[c] = letter
+				next = append(next, s)
+			}
+		}
+		subs = next[:min(len(next), maxL33tSubs)]
+	}
+
+	var matches []*pwMatch
+	lower := []rune(strings.ToLower(string(runes)))
+	if len(lower) != len(runes) {
+		return nil
+	}
+	for _, sub := range subs {
+		translated := make([]rune, len(lower))
+		for i, r := range lower {
+			if letter, ok := sub[r]; ok {
+				translated[i] = letter
+			} else {
+				translated[i] = r
+			}
+		}
+		for _, m := range e.lookupWords(runes, translated) {
+			used := make(map[rune]rune)
+			for _, r := range runes[m.i : m.j+1] {
+				if letter, ok := sub[r]; ok {
+					used[r] = letter
+				}
+			}
+			if len(used) == 0 || m.j == m.i {
+				continue
+			}
+			m.l33t = true
+			m.guesses *= l33tVariations(lower[m.i:m.j+1], used)
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+func containsRune(runes []rune, r rune) bool {
+	for _, c := range runes {
+		if c == r {
+			return true
+		}
+	}
+	return false
+}
+
+// uppercaseVariations returns the number of ways the word could have been capitalized, counting the common ways
+// of capitalizing the first letter, the last letter, or every letter as only two ways.
+func uppercaseVariations(word string) float64 {
+	var upper, lower int
+	for _, r := range word {
+		if unicode.IsUpper(r) {
+			upper++
+		} else if unicode.IsLower(r) {
+			lower++
+		}
+	}
+	if upper == 0 {
+		return 1
+	}
+	runes := []rune(word)
+	first, last := runes[0], runes[len(runes)-1]
+	if lower == 0 || upper == 1 && (unicode.IsUpper(first) || unicode.IsUpper(last)) {
+		return 2
+	}
+	return sumBinomials(upper+lower, min(upper, lower))
+}
+
+// l33tVariations returns the number of ways the substitutions in used could have been applied to the token.
+func l33tVariations(token []rune, used map[rune]rune) float64 {
+	variations := 1.0
+	for sub, letter := range used {
+		var subbed, unsubbed int
+		for _, r := range token {
+			if r == sub {
+				subbed++
+			} else if r == letter {
+				unsubbed++
+			}
+		}
+		if subbed == 0 || unsubbed == 0 {
+			variations *= 2
+		} else {
+			variations *= sumBinomials(subbed+unsubbed, min(subbed, unsubbed))
+		}
+	}
+	return variations
+}
+
+// sumBinomials returns the sum of n choose i, for i from 1 to k.
+func sumBinomials(n, k int) float64 {
+	var sum float64
+	for i := 1; i <= k; i++ {
+		sum += binomial(n, i)
+	}
+	return sum
+}
+
+func binomial(n, k int) float64 {
+	if k > n {
+		return 0
+	}
+	r := 1.0
+	for i := 1; i <= k; i++ {
+		r = r * float64(n-k+i) / float64(i)
+	}
+	return r
+}
+
+func factorial(n int) float64 {
+	r := 1.0
+	for i := 2; i <= n; i++ {
+		r *= float64(i)
+	}
+	return r
+}
+
+// keyboard is the layout of keys used to find keyboard patterns.
+type keyboard struct {
+	keys      map[rune]int  // the key each character is on
+	shifted   map[rune]bool // the characters typed with shift
+	neighbors []map[int]int // the keys next to each key, with the direction they are in
+	count     int           // the number of keys
+	degree    float64       // the average number of neighbors of a key
+}
+
+// newKeyboard makes a keyboard from rows of keys. Each key is a string of its unshifted and shifted characters,
+// and the keys of a row are separated by spaces. offsets are the horizontal positions of the first key of each row,
+// in key widths. Keys in neighboring rows are next to each other if their positions are less than a key apart,
+// or exactly one key apart when aligned is true.
+func newKeyboard(rows []string, offsets []float64, aligned bool) *keyboard {
+	type key struct {
+		row int
+		x   float64
+	}
+	kb := &keyboard{keys: map[rune]int{}, shifted: map[rune]bool{}}
+	var positions []key
+	for r, row := range rows {
+		for i, k := range strings.Fields(row) {
+			for j, c := range []rune(k) {
+				kb.keys[c] = len(positions)
+				kb.shifted[c] = j > 0
+			}
+			positions = append(positions, key{r, offsets[r] + float64(i)})
+		}
+	}
+	kb.count = len(positions)
+	var total int
+	for a, pa := range positions {
+		kb.neighbors = append(kb.neighbors, map[int]int{})
+		for b, pb := range positions {
+			dy, dx := pb.row-pa.row, pb.x-pa.x
+			near := dy == 0 && math.Abs(dx) == 1 ||
+				(dy == 1 || dy == -1) && (math.Abs(dx) < 1 || aligned && math.Abs(dx) == 1)
+			if a != b && near {
+				// the direction is one of the 9 combinations of up, level or down, and left, straight or right
+				sx := 0
+				if dx < 0 {
+					sx = -1
+				} else if dx > 0 {
+					sx = 1
+				}
+				kb.neighbors[a][b] = (dy+1)*3 + sx + 1
+				total++
+			}
+		}
+	}
+	kb.degree = float64(total) / float64(kb.count)
+	return kb
+}
+
+// keyboards are the layouts searched for keyboard patterns: a qwerty keyboard and a numeric keypad.
+var keyboards = sync.OnceValue(func() []*keyboard {
+	return []*keyboard{
+		newKeyboard([]string{
+			"`~ 1! 2@ 3# 4$ 5% 6^ 7& 8* 9( 0) -_ =+",
+			"qQ wW eE rR tT yY uU iI oO pP [{ ]} \\|",
+			"aA sS dD fF gG hH jJ kK lL ;: '\"",
+			"zZ xX cC vV bB nN mM ,< .> /?",
+		}, []float64{0, 1.5, 1.75, 2.25}, false),
+		newKeyboard([]string{
+			"/ * -",
+			"7 8 9 +",
+			"4 5 6",
+			"1 2 3",
+			"0 .",
+		}, []float64{1, 0, 0, 0, 0.5}, true),
+	}
+})
+
+// spatialMatches finds runs of three or more characters typed on neighboring keys, like "qwerty" or "zxcvfr".
+func spatialMatches(runes []rune) []*pwMatch {
+	var matches []*pwMatch
+	for _, kb := range keyboards() {
+		for i := 0; i < len(runes)-2; {
+			j, turns, lastDir := i+1, 0, -1
+			shifted := 0
+			if kb.shifted[runes[i]] {
+				shifted++
+			}
+			for ; j < len(runes); j++ {
+				a, okA := kb.keys[runes[j-1]]
+				b, okB := kb.keys[runes[j]]
+				dir, near := kb.neighbors[a][b]
+				if !okA || !okB || !near {
+					break
+				}
+				if dir != lastDir {
+					turns++
+					lastDir = dir
+				}
+				if kb.shifted[runes[j]] {
+					shifted++
+				}
+			}
+			if j-i >= 3 {
+				matches = append(matches, &pwMatch{
+					pattern: PatternSpatial, i: i, j: j - 1, token: string(runes[i:j]), turns: turns,
+					guesses: kb.guesses(j-i, turns, shifted),
+				})
+			}
+			i = j
+		}
+	}
+	return matches
+}
+
+// guesses returns the number of keyboard patterns of the given length and number of turns,
+// times the number of ways shift could have been used.
+func (kb *keyboard) guesses(length, turns, shifted int) float64 {
+	var g float64
+	for i := 2; i <= length; i++ {
+		for j := 1; j <= min(turns, i-1); j++ {
+			g += binomial(i-1, j-1) * float64(kb.count) * math.Pow(kb.degree, float64(j))
+		}
+	}
+	if unshifted := length - shifted; shifted > 0 {
+		if unshifted == 0 {
+			g *= 2
+		} else {
+			g *= sumBinomials(length, min(shifted, unshifted))
+		}
+	}
+	return g
+}
+
+// repeatMatches finds runs of repeated characters or strings, like "aaa" or "abcabc".
+// The guesses are those of the repeated part, times the number of repeats.
+func (e *strengthEstimator) repeatMatches(runes []rune) []*pwMatch {
+	var matches []*pwMatch
+	n := len(runes)
+	for i := 0; i < n-1; {
+		bestLen, bestBase := 0, 0
+		for b := 1; i+2*b <= n; b++ {
+			reps := 1
+			for i+(reps+1)*b <= n && string(runes[i+reps*b:i+(reps+1)*b]) == string(runes[i:i+b]) {
+				reps++
+			}
+			if reps >= 2 && reps*b > bestLen {
+				bestLen, bestBase = reps*b, b
+			}
+		}
+		if bestLen == 0 {
+			i++
+			continue
+		}
+		baseGuesses, _ := e.mostGuessable(runes[i : i+bestBase])
+		matches = append(matches, &pwMatch{
+			pattern: PatternRepeat, i: i, j: i + bestLen - 1, token: string(runes[i : i+bestLen]),
+			baseLen: bestBase, guesses: baseGuesses * float64(bestLen/bestBase),
+		})
+		i += bestLen
+	}
+	return matches
+}
+
+// sequenceMatches finds runs of three or more characters that go up or down by the same small step,
+// like "abc", "7531" or "ZYX".
+func sequenceMatches(runes []rune) []*pwMatch {
+	const maxDelta = 5
+	var matches []*pwMatch
+	for i := 0; i < len(runes)-1; {
+		delta := runes[i+1] - runes[i]
+		j := i + 1
+		for j+1 < len(runes) && runes[j+1]-runes[j] == delta {
+			j++
+		}
+		if j-i >= 2 && delta != 0 && delta >= -maxDelta && delta <= maxDelta {
+			base := 26.0
+			if strings.ContainsRune("aAzZ019", runes[i]) {
+				base = 4
+			} else if runes[i] >= '0' && runes[i] <= '9' {
+				base = 10
+			}
+			if delta < 0 {
+				base *= 2
+			}
+			matches = append(matches, &pwMatch{
+				pattern: PatternSequence, i: i, j: j, token: string(runes[i : j+1]),
+				guesses: base * float64(j-i+1),
+			})
+		}
+		i = j
+	}
+	return matches
+}
+
+// minYearSpace is the least number of years an attacker is assumed to try when guessing a year.
+const minYearSpace = 20
+
+func (e *strengthEstimator) yearSpace(year int) float64 {
+	return float64(max(abs(year-e.year), minYearSpace))
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+var yearPattern = regexp.MustCompile(`^(19|20)\d\d$`)
+
+// yearMatches finds years from 1900 to 2099.
+func (e *strengthEstimator) yearMatches(runes []rune) []*pwMatch {
+	var matches []*pwMatch
+	for i := 0; i+4 <= len(runes); i++ {
+		token := string(runes[i : i+4])
+		if yearPattern.MatchString(token) {
+			year, _ := strconv.Atoi(token)
+			matches = append(matches, &pwMatch{
+				pattern: PatternYear, i: i, j: i + 3, token: token, guesses: e.yearSpace(year),
+			})
+		}
+	}
+	return matches
+}
+
+var datePattern = regexp.MustCompile(`^(\d{1,4})([\s/\\_.-])(\d{1,2})([\s/\\_.-])(\d{1,4})$`)
+
+// dateSplits are the ways of splitting a date written without separators into three numbers,
+// for each length of date.
+var dateSplits = map[int][][2]int{
+	4: {{1, 2}, {2, 3}},
+	5: {{1, 3}, {2, 3}},
+	6: {{1, 2}, {2, 4}, {4, 5}},
+	7: {{1, 3}, {2, 3}, {4, 5}, {4, 6}},
+	8: {{2, 4}, {4, 6}},
+}
+
+// dateMatches finds dates with the day, month and year in any common order, like "13/5/1998", "1998-05-13"
+// or "130598". A date that can be read in more than one way is read with the year closest to now.
+func (e *strengthEstimator) dateMatches(runes []rune) []*pwMatch {
+	var matches []*pwMatch
+	for i := range runes {
+		for j := i + 3; j < min(i+10, len(runes)); j++ {
+			token := string(runes[i : j+1])
+			var year int
+			var ok, separated bool
+			if isDigits(token) && len(token) <= 8 {
+				for _, split := range dateSplits[len(token)] {
+					a, _ := strconv.Atoi(token[:split[0]])
+					b, _ := strconv.Atoi(token[split[0]:split[1]])
+					c, _ := strconv.Atoi(token[split[1]:])
+					if y, found := dateYear(a, b, c); found && (!ok || abs(y-e.year) < abs(year-e.year)) {
+						year, ok = y, true
+					}
+				}
+			} else if parts := datePattern.FindStringSubmatch(token); parts != nil && parts[2] == parts[4] {
+				a, _ := strconv.Atoi(parts[1])
+				b, _ := strconv.Atoi(parts[3])
+				c, _ := strconv.Atoi(parts[5])
+				year, ok = dateYear(a, b, c)
+				separated = true
+			}
+			if !ok {
+				continue
+			}
+			g := e.yearSpace(year) * 365
+			if separated {
+				g *= 4
+			}
+			matches = append(matches, &pwMatch{pattern: PatternDate, i: i, j: j, token: token, guesses: g})
+		}
+	}
+	return matches
+}
+
+// dateYear returns the year of the date made of the three numbers, if they are a valid day, month and year
+// in some order, with the year first or last. Two digit years are taken to be from 1951 to 2050.
+func dateYear(a, b, c int) (year int, ok bool) {
+	const minYear, maxYear = 1000, 2050
+	if b > 31 || b <= 0 {
+		return 0, false
+	}
+	var over12, over31, under1 int
+	for _, v := range []int{a, b, c} {
+		if v > 99 && v < minYear || v > maxYear {
+			return 0, false
+		}
+		if v > 31 {
+			over31++
+		}
+		if v > 12 {
+			over12++
+		}
+		if v <= 0 {
+			under1++
+		}
+	}
+	if over31 >= 2 || over12 == 3 || under1 >= 2 {
+		return 0, false
+	}
+	isDayMonth := func(d, m int) bool {
+		return d >= 1 && d <= 31 && m >= 1 && m <= 12 || m >= 1 && m <= 31 && d >= 1 && d <= 12
+	}
+	splits := [][3]int{{c, a, b}, {a, b, c}}
+	for _, s := range splits {
+		if s[0] >= minYear && s[0] <= maxYear {
+			return s[0], isDayMonth(s[1], s[2])
+		}
+	}
+	for _, s := range splits {
+		if isDayMonth(s[1], s[2]) {
+			switch y := s[0]; {
+			case y > 99:
+				return y, true
+			case y > 50:
+				return y + 1900, true
+			default:
+				return y + 2000, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// strengthFeedback explains what makes a password weak, based on its longest match.
+func strengthFeedback(score int, seq []*pwMatch) (warning string, suggestions []string) {
+	if len(seq) == 0 {
+		return "", []string{
+			"Use a few words, avoid common phrases.",
+			"No need for symbols, digits, or uppercase letters.",
+		}
+	}
+	if score > 2 {
+		return "", nil
+	}
+	longest := seq[0]
+	for _, m := range seq[1:] {
+		if len([]rune(m.token)) > len([]rune(longest.token)) {
+			longest = m
+		}
+	}
+	suggestions = []string{"Add another word or two. Uncommon words are better."}
+
+	switch longest.pattern {
+	case PatternDictionary:
+		sole := len(seq) == 1
+		switch {
+		case longest.dict == "passwords" && sole && !longest.l33t && !longest.reversed:
+			switch {
+			case longest.rank <= 10:
+				warning = "This is a top-10 common password."
+			case longest.rank <= 100:
+				warning = "This is a top-100 common password."
+			default:
+				warning = "This is a very common password."
+			}
+		case longest.dict == "passwords" && longest.guesses <= 1e4:
+			warning = "This is similar to a commonly used password."
+		case longest.dict == "english" && sole:
+			warning = "A word by itself is easy to guess."
+		case longest.dict == "names" && sole:
+			warning = "Names and surnames by themselves are easy to guess."
+		case longest.dict == "names":
+			warning = "Common names and surnames are easy to guess."
+		case longest.dict == "user":
+			warning = "Passwords based on your name or account are easy to guess."
+		}
+		token := longest.token
+		runes := []rune(token)
+		if unicode.IsUpper(runes[0]) && strings.ToUpper(token) != token {
+			suggestions = append(suggestions, "Capitalization doesn't help very much.")
+		} else if strings.ToUpper(token) == token && strings.ToLower(token) != token {
+			suggestions = append(suggestions, "All-uppercase is almost as easy to guess as all-lowercase.")
+		}
+		if longest.reversed && len(runes) >= 4 {
+			suggestions = append(suggestions, "Reversed words aren't much harder to guess.")
+		}
+		if longest.l33t {
+			suggestions = append(suggestions, "Predictable substitutions like '@' instead of 'a' don't help very much.")
+		}
+	case PatternSpatial:
+		warning = "Short keyboard patterns are easy to guess."
+		if longest.turns == 1 {
+			warning = "Straight rows of keys are easy to guess."
+		}
+		suggestions = append(suggestions, "Use a longer keyboard pattern with more turns.")
+	case PatternRepeat:
+		warning = `Repeats like "abcabcabc" are only slightly harder to guess than "abc".`
+		if longest.baseLen == 1 {
+			warning = `Repeats like "aaa" are easy to guess.`
+		}
+		suggestions = append(suggestions, "Avoid repeated words and characters.")
+	case PatternSequence:
+		warning = "Sequences like abc or 6543 are easy to guess."
+		suggestions = append(suggestions, "Avoid sequences.")
+	case PatternYear:
+		warning = "Recent years are easy to guess."
+		suggestions = append(suggestions, "Avoid recent years.", "Avoid years that are associated with you.")
+	case PatternDate:
+		warning = "Dates are often easy to guess."
+		suggestions = append(suggestions, "Avoid dates and years that are associated with you.")
+	}
+	return warning, suggestions
+}
@@ -0,0 +1,124 @@
+package strings
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func ExampleEstimateStrength() {
+	s := EstimateStrength("Password1!")
+	fmt.Println(s.Score, s.Warning)
+	s = EstimateStrength("correct horse battery staple")
+	fmt.Println(s.Score)
+	//Output: 1 This is similar to a commonly used password.
+	//4
+}
+
+func TestEntropy(t *testing.T) {
+	tests := []struct {
+		source string
+		n      int
+		want   float64
+	}{
+		{AlphaNumeric, 20, 20 * math.Log2(62)},
+		{Numbers, 6, 6 * math.Log2(10)},
+		{"ab", 10, 10},
+		{"aab", 1, math.Log2(3) - 2.0/3},
+		{"a", 10, 0},
+		{"", 10, 0},
+	}
+	for _, tt := range tests {
+		if got := Entropy(tt.source, tt.n); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Entropy(%q, %d) = %v, want %v", tt.source, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestEstimateStrength(t *testing.T) {
+	tests := []struct {
+		pw       string
+		minScore int
+		maxScore int
+		pattern  string // the pattern of the longest match
+		warning  string // part of the warning
+	}{
+		{"", 0, 0, "", ""},
+		{"password", 0, 0, PatternDictionary, "top-10"},
+		{"PASSWORD", 0, 0, PatternDictionary, "top-10"},
+		{"Password1!", 0, 1, PatternDictionary, "similar to a commonly used"},
+		{"p@ssw0rd", 0, 0, PatternDictionary, "similar to a commonly used"},
+		{"drowssap", 0, 0, PatternDictionary, "similar to a commonly used"},
+		{"jennifer", 0, 0, PatternDictionary, "top-100"},
+		{"qwertyuiop", 0, 0, PatternDictionary, "top-100"},
+		{"zxcvfr", 0, 1, PatternSpatial, "keyboard patterns"},
+		{"asdfghjk", 0, 1, PatternSpatial, "Straight rows"},
+		{"aaaaaaaa", 0, 0, PatternRepeat, `like "aaa"`},
+		{"xyzxyzxyz", 0, 0, PatternRepeat, `like "abcabcabc"`},
+		{"abcdefgh", 0, 0, PatternSequence, "Sequences"},
+		{"97531", 0, 0, PatternSequence, "Sequences"},
+		{"1998", 0, 0, PatternYear, "Recent years"},
+		{"13/05/1998", 0, 1, PatternDate, "Dates"},
+		{"19980513", 0, 1, PatternDate, "Dates"},
+		{"x7$Kp!qZ2m#v", 4, 4, PatternBruteforce, ""},
+		{"correct horse battery staple", 4, 4, "", ""},
+		{strings.Repeat("x7$Kp!qZ2m#v", 20), 4, 4, "", ""},
+		{strings.Repeat("a", 110), 0, 1, PatternRepeat, `like "aaa"`},
+		{strings.Repeat("a", 1000), 0, 1, PatternRepeat, `like "aaa"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pw, func(t *testing.T) {
+			s := EstimateStrength(tt.pw)
+			if s.Score < tt.minScore || s.Score > tt.maxScore {
+				t.Errorf("Score = %d, want %d to %d (guesses %v, matches %v)", s.Score, tt.minScore, tt.maxScore, s.Guesses, s.Matches)
+			}
+			if tt.pattern != "" {
+				longest := s.Matches[0]
+				for _, m := range s.Matches {
+					if len(m.Token) > len(longest.Token) {
+						longest = m
+					}
+				}
+				if longest.Pattern != tt.pattern {
+					t.Errorf("longest match = %v, want pattern %s", longest, tt.pattern)
+				}
+			}
+			if !strings.Contains(s.Warning, tt.warning) {
+				t.Errorf("Warning = %q, want it to contain %q", s.Warning, tt.warning)
+			}
+			if s.Score <= 2 && len(s.Suggestions) == 0 {
+				t.Error("weak password has no suggestions")
+			}
+			if s.Score > 2 && (s.Warning != "" || len(s.Suggestions) > 0) {
+				t.Errorf("strong password has feedback %q %q", s.Warning, s.Suggestions)
+			}
+			if s.CrackTimeOffline > s.CrackTimeOnline {
+				t.Errorf("offline crack time %v is longer than online %v", s.CrackTimeOffline, s.CrackTimeOnline)
+			}
+		})
+	}
+}
+
+func TestEstimateStrengthWith(t *testing.T) {
+	pw := "Goradd"
+	without := EstimateStrength(pw)
+	with := EstimateStrengthWith(pw, StrengthOptions{UserInputs: []string{"goradd", "framework"}})
+	if with.Guesses >= without.Guesses || with.Score != 0 {
+		t.Errorf("user inputs did not weaken %q: %v guesses with, %v without", pw, with.Guesses, without.Guesses)
+	}
+	if !strings.Contains(with.Warning, "your name or account") {
+		t.Errorf("Warning = %q", with.Warning)
+	}
+}
+
+func TestEstimateStrengthMatches(t *testing.T) {
+	s := EstimateStrength("MichaelJordan")
+	var tokens []string
+	for _, m := range s.Matches {
+		tokens = append(tokens, m.Token)
+	}
+	if strings.Join(tokens, "|") != "Michael|Jordan" {
+		t.Errorf("Matches = %v", s.Matches)
+	}
+}
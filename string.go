@@ -63,6 +63,7 @@ func ContainsAnyStrings(haystack string, needles ...string) bool {
 }
 
 // HasCharType returns true if the given string has at least one of the selected char types.
+// Having every char type does not make a password strong. Use EstimateStrength to judge a password.
 func HasCharType(s string, wantUpper, wantLower, wantDigit, wantPunc, wantSymbol bool) bool {
 	var hasUpper, hasLower, hasDigit, hasPunc, hasSymbol bool
 
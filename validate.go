@@ -43,6 +43,7 @@ func IsUTF8Bytes(b []byte) bool {
 
 // IsInt returns true if the given string is an integer.
 // Allows the string to start with a + or -.
+// Grouping separators, like the comma in "1,000", are not allowed. Pass the string through NormalizeNumber first to allow them.
 func IsInt(s string) bool {
 	_, err := strconv.Atoi(s)
 	return err == nil
@@ -50,6 +51,7 @@ func IsInt(s string) bool {
 
 // IsFloat returns true if the given string is a floating point number.
 // Allows the string to start with a + or -.
+// Grouping separators, like the comma in "1,000.5", are not allowed. Pass the string through NormalizeNumber first to allow them.
 func IsFloat(s string) bool {
 	_, err := strconv.ParseFloat(s, 64)
 	return err == nil
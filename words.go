@@ -0,0 +1,139 @@
+package strings
+
+import (
+	"golang.org/x/text/language"
+	"unicode"
+	"unicode/utf8"
+)
+
+// runeClass is the category a rune falls in for the purpose of finding word boundaries.
+type runeClass int
+
+const (
+	classSep   runeClass = iota // anything that is not part of a word, like spaces, underscores and hyphens
+	classUpper                  // upper and title case letters
+	classLower                  // lower case letters
+	classDigit                  // numbers of any script
+	classOther                  // letters that have no case, like CJK ideographs
+	classMark                   // combining marks, which stay with the rune before them
+)
+
+func classOf(r rune) runeClass {
+	switch {
+	case unicode.IsUpper(r) || unicode.IsTitle(r):
+		return classUpper
+	case unicode.IsLower(r):
+		return classLower
+	case unicode.IsLetter(r):
+		return classOther
+	case unicode.IsNumber(r):
+		return classDigit
+	case unicode.IsMark(r):
+		return classMark
+	}
+	return classSep
+}
+
+// CaseOptions control how the "With" variants of the case conversion functions find and join words.
+// The zero value gives the same results as the functions without options.
+type CaseOptions struct {
+	// SplitDigits makes each run of numbers a word of its own, so that "Address2Line" becomes
+	// "address_2_line" rather than "address2_line".
+	SplitDigits bool
+	// Acronyms are the words that are kept in upper case when words are capitalized, and whose plurals,
+	// like "IDs", are kept together when splitting words. Nil means DefaultAcronyms. Use an empty
+	// Acronyms to turn off acronym handling.
+	Acronyms Acronyms
+	// Language selects language specific case mapping rules, like the dotted and dotless i of Turkish.
+	// The zero value uses rules that suit most languages.
+	Language language.Tag
+}
+
+func (o CaseOptions) acronyms() Acronyms {
+	if o.Acronyms == nil {
+		return DefaultAcronyms
+	}
+	return o.Acronyms
+}
+
+// Words splits s into the words that make it up, and is the basis of all the case conversion functions
+// in this package.
+//
+// Any rune that is not a letter, number or combining mark separates words and is dropped.
+// Within a run of letters and numbers, a new word starts:
+//   - at an upper case letter that follows a lower case letter, a number, or a letter with no case ("fooBar" -> "foo", "Bar").
+//   - at the last upper case letter of a run of upper case letters that is followed by a lower case letter ("HTTPServer" -> "HTTP", "Server").
+//
+// Numbers stay with the word before them ("Address2Line" -> "Address2", "Line").
+// The plural of an acronym in DefaultAcronyms is kept as one word ("UserIDs" -> "User", "IDs").
+// The case of the returned words is not changed.
+func Words(s string) []string {
+	return WordsWith(s, CaseOptions{})
+}
+
+// WordsWith splits s into words like Words does, but using the given options.
+func WordsWith(s string, opts CaseOptions) []string {
+	var words []string
+	start := -1        // byte offset of the start of the current word, or -1 if not in a word
+	var prev runeClass // class of the previous rune in the current word
+	var prevPos int    // byte offset of the previous rune in the current word
+	var prevPrev runeClass
+
+	for i, r := range s {
+		c := classOf(r)
+		if c == classMark {
+			// marks modify the rune before them, so never start or end a word
+			continue
+		}
+		if c == classSep {
+			if start != -1 {
+				words = append(words, s[start:i])
+				start = -1
+			}
+			continue
+		}
+		if start == -1 {
+			start = i
+			prev, prevPos, prevPrev = c, i, classSep
+			continue
+		}
+		switch {
+		case opts.SplitDigits && (c == classDigit) != (prev == classDigit):
+			words = append(words, s[start:i])
+			start = i
+			prevPrev = classSep
+		case c == classUpper && (prev == classLower || prev == classDigit || prev == classOther):
+			words = append(words, s[start:i])
+			start = i
+			prevPrev = classSep
+		case c == classLower && prev == classUpper && prevPrev == classUpper &&
+			!isAcronymPlural(s, start, i, opts.acronyms()):
+			// the last upper case letter of an upper case run belongs with the lower case letters after it
+			words = append(words, s[start:prevPos])
+			start = prevPos
+			prevPrev = classUpper
+		default:
+			prevPrev = prev
+		}
+		prev, prevPos = c, i
+	}
+	if start != -1 {
+		words = append(words, s[start:])
+	}
+	return words
+}
+
+// isAcronymPlural returns true if s[start:i] is an acronym and is followed by an "s" at i that ends the word.
+func isAcronymPlural(s string, start, i int, acronyms Acronyms) bool {
+	if s[i] != 's' || !acronyms.Has(s[start:i]) {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(s[i+1:])
+	return classOf(r) != classLower
+}
+
+// startsWithLetter returns true if the first rune of w is a letter.
+func startsWithLetter(w string) bool {
+	r, _ := utf8.DecodeRuneInString(w)
+	return unicode.IsLetter(r)
+}
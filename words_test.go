@@ -0,0 +1,62 @@
+package strings
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func ExampleWords() {
+	fmt.Printf("%q\n", Words("parseHTTPResponse_v2"))
+	//Output: ["parse" "HTTP" "Response" "v2"]
+}
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"separators only", "_- ./", nil},
+		{"lower", "abc", []string{"abc"}},
+		{"upper", "ABC", []string{"ABC"}},
+		{"camel", "abcDef", []string{"abc", "Def"}},
+		{"pascal", "AbcDef", []string{"Abc", "Def"}},
+		{"acronym start", "HTTPServer", []string{"HTTP", "Server"}},
+		{"acronym end", "ManagerID", []string{"Manager", "ID"}},
+		{"acronym plural", "UserIDsFor", []string{"User", "IDs", "For"}},
+		{"unknown plural", "ABs", []string{"A", "Bs"}},
+		{"acronym middle", "AbcDEFghi", []string{"Abc", "DE", "Fghi"}},
+		{"single upper", "aB", []string{"a", "B"}},
+		{"snake", "do_i_seeYou", []string{"do", "i", "see", "You"}},
+		{"kebab", "this-THAT", []string{"this", "THAT"}},
+		{"spaces", "  I Like  Kiwis ", []string{"I", "Like", "Kiwis"}},
+		{"mixed separators", "a.b/c-d_e f", []string{"a", "b", "c", "d", "e", "f"}},
+		{"digits trailing", "with_numbers_123", []string{"with", "numbers", "123"}},
+		{"digits in camel", "Address2Line", []string{"Address2", "Line"}},
+		{"digits after acronym", "HTTP2Server", []string{"HTTP2", "Server"}},
+		{"digits then lower", "v2beta", []string{"v2beta"}},
+		{"leading digits", "123abc", []string{"123abc"}},
+		{"unicode lower", "élan_vital", []string{"élan", "vital"}},
+		{"unicode camel", "naïveÉcole", []string{"naïve", "École"}},
+		{"combining mark", "naïveCafe", []string{"naïve", "Cafe"}},
+		{"no case", "日本語Text", []string{"日本語", "Text"}},
+		{"greek", "ΑβγΔεζ", []string{"Αβγ", "Δεζ"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Words(tt.s); !slices.Equal(got, tt.want) {
+				t.Errorf("Words(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordsWith_SplitDigits(t *testing.T) {
+	got := WordsWith("v2beta3HTTP2Server", CaseOptions{SplitDigits: true})
+	want := []string{"v", "2", "beta", "3", "HTTP", "2", "Server"}
+	if !slices.Equal(got, want) {
+		t.Errorf("WordsWith() = %q, want %q", got, want)
+	}
+}